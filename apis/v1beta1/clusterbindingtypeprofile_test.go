@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestClusterBindingTypeProfileValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterBindingTypeProfile
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &ClusterBindingTypeProfile{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "valid",
+			seed: &ClusterBindingTypeProfile{
+				Spec: ClusterBindingTypeProfileSpec{
+					Env: []EnvMapping{
+						{
+							Name: "PGHOST",
+							Key:  "host",
+						},
+						{
+							Name: "PGUSER",
+							Key:  "username",
+						},
+					},
+					RequiredKeys: []string{"host", "username", "password"},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid env",
+			seed: &ClusterBindingTypeProfile{
+				Spec: ClusterBindingTypeProfileSpec{
+					Env: []EnvMapping{
+						{
+							Name: "PGHOST",
+							Key:  "host",
+						},
+						{},
+						{
+							Name: "PGHOST",
+							Key:  "hostname",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "env").Index(1).Child("name"), ""),
+				field.Required(field.NewPath("spec", "env").Index(1).Child("key"), ""),
+				field.Duplicate(field.NewPath("spec", "env", "[0, 2]", "name"), "PGHOST"),
+			},
+		},
+		{
+			name: "invalid required keys",
+			seed: &ClusterBindingTypeProfile{
+				Spec: ClusterBindingTypeProfileSpec{
+					RequiredKeys: []string{"host", "", "host"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "requiredKeys").Index(1), ""),
+				field.Duplicate(field.NewPath("spec", "requiredKeys", "[0, 2]"), "host"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ClusterBindingTypeProfileSpec defines the desired state of ClusterBindingTypeProfile
+type ClusterBindingTypeProfileSpec struct {
+	// Env is the collection of default mappings from Secret entries to environment variables for service bindings of
+	// the type. A mapping defined by the ServiceBinding for the same environment variable takes precedence.
+	Env []EnvMapping `json:"env,omitempty"`
+	// RequiredKeys are the entries the binding Secret must contain for service bindings of the type
+	RequiredKeys []string `json:"requiredKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterBindingTypeProfile is the Schema for the clusterbindingtypeprofiles API. The name of the profile is the
+// ServiceBinding `type` it applies to, like `postgresql`.
+type ClusterBindingTypeProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterBindingTypeProfileSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterBindingTypeProfileList contains a list of ClusterBindingTypeProfile
+type ClusterBindingTypeProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterBindingTypeProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterBindingTypeProfile{}, &ClusterBindingTypeProfileList{})
+}
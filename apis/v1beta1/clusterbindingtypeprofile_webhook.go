@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ClusterBindingTypeProfile) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-clusterbindingtypeprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterbindingtypeprofiles,verbs=create;update,versions=v1beta1,name=vclusterbindingtypeprofile.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ClusterBindingTypeProfile{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterBindingTypeProfile) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterBindingTypeProfile) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterBindingTypeProfile) ValidateDelete() error {
+	return nil
+}
+
+func (r *ClusterBindingTypeProfile) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+
+	return errs
+}
+
+func (r *ClusterBindingTypeProfileSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	envNames := map[string]int{}
+	for i := range r.Env {
+		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
+		// check for duplicate names
+		if n := r.Env[i].Name; n != "" {
+			if j, ok := envNames[n]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("env", fmt.Sprintf("[%d, %d]", j, i), "name"), n))
+			}
+			envNames[n] = i
+		}
+	}
+	keys := map[string]int{}
+	for i, k := range r.RequiredKeys {
+		if k == "" {
+			errs = append(errs, field.Required(fldPath.Child("requiredKeys").Index(i), ""))
+			continue
+		}
+		// check for duplicate keys
+		if j, ok := keys[k]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child("requiredKeys", fmt.Sprintf("[%d, %d]", j, i)), k))
+		}
+		keys[k] = i
+	}
+
+	return errs
+}
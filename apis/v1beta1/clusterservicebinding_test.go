@@ -0,0 +1,369 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+func TestClusterServiceBindingDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceBinding
+		expected *ClusterServiceBinding
+	}{
+		{
+			name: "default name",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+			},
+			expected: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "my-binding",
+					},
+				},
+			},
+		},
+		{
+			name: "preserve name",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "preserved-name",
+					},
+				},
+			},
+			expected: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "preserved-name",
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.Default()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceBindingValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceBinding
+		expected field.ErrorList
+	}{
+		{
+			name: "empty is not valid",
+			seed: &ClusterServiceBinding{},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "name"), ""),
+				field.Required(field.NewPath("spec", "service", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "service", "kind"), ""),
+				field.Required(field.NewPath("spec", "service", "[name, selector]"), "expected exactly one, got neither"),
+				field.Required(field.NewPath("spec", "workload", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "workload", "kind"), ""),
+				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got neither"),
+				field.Required(field.NewPath("spec", "service", "namespace"), ""),
+			},
+		},
+		{
+			name: "valid",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "my-binding",
+						Service: ServiceBindingServiceReference{
+							APIVersion: "v1",
+							Kind:       "Secret",
+							Name:       "my-service",
+							Namespace:  "my-services",
+						},
+						Workload: ServiceBindingWorkloadReference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"app": "my-app",
+								},
+							},
+						},
+					},
+					NamespaceSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"tenant": "true",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "services require namespace",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Services: []ServiceBindingServiceEntry{
+							{
+								Name: "db",
+								Service: ServiceBindingServiceReference{
+									APIVersion: "v1",
+									Kind:       "Secret",
+									Name:       "my-database",
+									Namespace:  "my-services",
+								},
+							},
+							{
+								Name: "cache",
+								Service: ServiceBindingServiceReference{
+									APIVersion: "v1",
+									Kind:       "Secret",
+									Name:       "my-cache",
+								},
+							},
+						},
+						Workload: ServiceBindingWorkloadReference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-workload",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "services").Index(1).Child("service", "namespace"), ""),
+			},
+		},
+		{
+			name: "invalid namespace selector",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "my-binding",
+						Service: ServiceBindingServiceReference{
+							APIVersion: "v1",
+							Kind:       "Secret",
+							Name:       "my-service",
+							Namespace:  "my-services",
+						},
+						Workload: ServiceBindingWorkloadReference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-workload",
+						},
+					},
+					NamespaceSelector: metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "tenant",
+								Operator: "Unknown",
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "namespaceSelector"), metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "tenant",
+							Operator: "Unknown",
+						},
+					},
+				}, "\"Unknown\" is not a valid pod selector operator"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceBindingServiceBinding(t *testing.T) {
+	now := metav1.Now()
+	seed := &ClusterServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-binding",
+			UID:               "dde10100-d7b3-4cba-9430-51d60a8612a6",
+			Generation:        2,
+			DeletionTimestamp: &now,
+		},
+		Spec: ClusterServiceBindingSpec{
+			ServiceBindingSpec: ServiceBindingSpec{
+				Name: "my-binding",
+				Workload: ServiceBindingWorkloadReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "my-workload",
+				},
+			},
+			NamespaceSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"tenant": "true",
+				},
+			},
+		},
+		Status: ClusterServiceBindingStatus{
+			ServiceBindingStatus: ServiceBindingStatus{
+				Binding: &ServiceBindingSecretReference{
+					Name: "servicebinding-secret-dde10100-d7b3-4cba-9430-51d60a8612a6",
+				},
+				Workloads: []ServiceBindingWorkloadStatus{
+					{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "my-namespace", Name: "my-workload", UID: "b2e5b8f6-0c43-4b7c-8a1d-5f8e7b0d6c21"},
+					{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "other-namespace", Name: "my-workload", UID: "4a1f9d2e-6b3c-4e8a-9f7d-2c5b8e1a0d34"},
+				},
+				WorkloadCount: 2,
+			},
+			Namespaces: []string{"my-namespace", "other-namespace"},
+		},
+	}
+	expected := &ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "my-namespace",
+			Name:              "my-binding",
+			UID:               "dde10100-d7b3-4cba-9430-51d60a8612a6",
+			Generation:        2,
+			DeletionTimestamp: &now,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1beta1",
+					Kind:               "ClusterServiceBinding",
+					Name:               "my-binding",
+					UID:                "dde10100-d7b3-4cba-9430-51d60a8612a6",
+					Controller:         pointer.Bool(true),
+					BlockOwnerDeletion: pointer.Bool(true),
+				},
+			},
+		},
+		Spec: ServiceBindingSpec{
+			Name: "my-binding",
+			Workload: ServiceBindingWorkloadReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "my-workload",
+			},
+		},
+		Status: ServiceBindingStatus{
+			Binding: &ServiceBindingSecretReference{
+				Name: "servicebinding-secret-dde10100-d7b3-4cba-9430-51d60a8612a6",
+			},
+			Workloads: []ServiceBindingWorkloadStatus{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "my-workload", UID: "b2e5b8f6-0c43-4b7c-8a1d-5f8e7b0d6c21"},
+			},
+			WorkloadCount: 1,
+		},
+	}
+
+	if diff := cmp.Diff(expected, seed.ServiceBinding("my-namespace")); diff != "" {
+		t.Errorf("(-expected, +actual): %s", diff)
+	}
+}
+
+func TestClusterServiceBindingSelectsNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector metav1.LabelSelector
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "empty selector",
+			labels:   map[string]string{},
+			expected: true,
+		},
+		{
+			name: "matching labels",
+			selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "true"},
+			},
+			labels:   map[string]string{"tenant": "true"},
+			expected: true,
+		},
+		{
+			name: "labels not matching",
+			selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "true"},
+			},
+			labels:   map[string]string{"tenant": "false"},
+			expected: false,
+		},
+		{
+			name: "invalid selector",
+			selector: metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tenant", Operator: "Unknown"},
+				},
+			},
+			labels:   map[string]string{"tenant": "true"},
+			expected: false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					NamespaceSelector: c.selector,
+				},
+			}
+			namespace := &metav1.ObjectMeta{Name: "my-namespace", Labels: c.labels}
+			if actual := binding.SelectsNamespace(namespace); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ClusterServiceBindingSpec defines the desired state of ClusterServiceBinding
+type ClusterServiceBindingSpec struct {
+	// ServiceBindingSpec is the binding projected into the workloads of each selected namespace. The namespace of the
+	// service must be set, there is no namespace for the service to default to.
+	ServiceBindingSpec `json:",inline"`
+	// NamespaceSelector selects the namespaces the binding is projected into. An empty selector selects every
+	// namespace.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// ClusterServiceBindingStatus defines the observed state of ClusterServiceBinding
+type ClusterServiceBindingStatus struct {
+	ServiceBindingStatus `json:",inline"`
+	// Namespaces are the namespaces the binding is projected into. Workloads in namespaces that are no longer selected
+	// are unprojected.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.status.binding.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,JSONPath=`.status.workloadCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterServiceBinding is the Schema for the clusterservicebindings API. A cluster service binding projects a service
+// into the workloads of every namespace selected by the binding, as if a ServiceBinding existed in each namespace.
+type ClusterServiceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterServiceBindingSpec   `json:"spec,omitempty"`
+	Status ClusterServiceBindingStatus `json:"status,omitempty"`
+}
+
+// ServiceBinding returns the cluster binding as a ServiceBinding in the namespace. The service binding shares the uid
+// of the cluster binding and is controlled by the cluster binding, resources created in the namespace for the service
+// binding are owned by the cluster binding.
+func (r *ClusterServiceBinding) ServiceBinding(namespace string) *ServiceBinding {
+	serviceBinding := &ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              r.Name,
+			UID:               r.UID,
+			Generation:        r.Generation,
+			CreationTimestamp: r.CreationTimestamp,
+			DeletionTimestamp: r.DeletionTimestamp,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(r, GroupVersion.WithKind("ClusterServiceBinding")),
+			},
+		},
+		Spec:   *r.Spec.ServiceBindingSpec.DeepCopy(),
+		Status: *r.Status.ServiceBindingStatus.DeepCopy(),
+	}
+	// only the workloads in the namespace are bound by the service binding
+	serviceBinding.Status.Workloads = nil
+	for _, workload := range r.Status.Workloads {
+		if workload.Namespace == namespace {
+			workload.Namespace = ""
+			serviceBinding.Status.Workloads = append(serviceBinding.Status.Workloads, workload)
+		}
+	}
+	serviceBinding.Status.WorkloadCount = int32(len(serviceBinding.Status.Workloads))
+	return serviceBinding
+}
+
+// SelectsNamespace returns true when the namespace is selected by the binding's namespace selector
+func (r *ClusterServiceBinding) SelectsNamespace(namespace *metav1.ObjectMeta) bool {
+	selector, err := metav1.LabelSelectorAsSelector(&r.Spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(namespace.Labels))
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterServiceBindingList contains a list of ClusterServiceBinding
+type ClusterServiceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterServiceBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterServiceBinding{}, &ClusterServiceBindingList{})
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ClusterServiceBinding) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+var _ webhook.Defaulter = &ClusterServiceBinding{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClusterServiceBinding) Default() {
+	if r.Spec.Name == "" && len(r.Spec.Services) == 0 {
+		r.Spec.Name = r.Name
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-clusterservicebinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterservicebindings,verbs=create;update,versions=v1beta1,name=vclusterservicebinding.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ClusterServiceBinding{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceBinding) ValidateCreate() error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceBinding) ValidateUpdate(old runtime.Object) error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceBinding) ValidateDelete() error {
+	return nil
+}
+
+func (r *ClusterServiceBinding) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+
+	return errs
+}
+
+func (r *ClusterServiceBindingSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.ServiceBindingSpec.validate(fldPath)...)
+	// the binding is cluster scoped, there is no namespace for the service to default to
+	if len(r.Services) == 0 {
+		if r.Service.Namespace == "" {
+			errs = append(errs, field.Required(fldPath.Child("service", "namespace"), ""))
+		}
+	}
+	for i := range r.Services {
+		if r.Services[i].Service.Namespace == "" {
+			errs = append(errs, field.Required(fldPath.Child("services").Index(i).Child("service", "namespace"), ""))
+		}
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&r.NamespaceSelector); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("namespaceSelector"), r.NamespaceSelector, err.Error()))
+	}
+
+	return errs
+}
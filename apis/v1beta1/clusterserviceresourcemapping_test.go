@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestClusterServiceResourceMappingDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceResourceMapping
+		expected *ClusterServiceResourceMapping
+	}{
+		{
+			name: "provisioned service defaults",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".status.binding.name",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no defaults for synthesized secret",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Secret: &ClusterServiceResourceMappingSecret{
+								Entries: []ClusterServiceResourceMappingEntry{
+									{
+										Key:  "host",
+										Path: ".status.host",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Secret: &ClusterServiceResourceMappingSecret{
+								Entries: []ClusterServiceResourceMappingEntry{
+									{
+										Key:  "host",
+										Path: ".status.host",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.Default()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Default (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceResourceMappingValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceResourceMapping
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &ClusterServiceResourceMapping{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "secret name",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".spec.connections[?(@.default==true)].secretName",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "synthesized secret",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "v1",
+							Secret: &ClusterServiceResourceMappingSecret{
+								Entries: []ClusterServiceResourceMappingEntry{
+									{
+										Key:  "host",
+										Path: ".status.endpoint.address",
+									},
+									{
+										Key: "password",
+										SecretKeyRef: &ClusterServiceResourceMappingSecretKeyRef{
+											Name: ".spec.passwordSecretRef.name",
+											Key:  "password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "duplicate version is invalid",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+						},
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "versions", "[0, 1]", "version"), "*"),
+			},
+		},
+		{
+			name: "version is required",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("version"), ""),
+			},
+		},
+		{
+			name: "invalid secret name",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".spec[",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "versions").Index(0).Child("secretName"), ".spec[", "unterminated array"),
+			},
+		},
+		{
+			name: "secret name and secret are mutually exclusive",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".status.binding.name",
+							Secret: &ClusterServiceResourceMappingSecret{
+								Entries: []ClusterServiceResourceMappingEntry{
+									{
+										Key:  "host",
+										Path: ".status.host",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "versions").Index(0).Child("secretName"), "must not be set with secret"),
+			},
+		},
+		{
+			name: "secret entries are required",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Secret:  &ClusterServiceResourceMappingSecret{},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("secret", "entries"), ""),
+			},
+		},
+		{
+			name: "invalid secret entries",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Secret: &ClusterServiceResourceMappingSecret{
+								Entries: []ClusterServiceResourceMappingEntry{
+									{
+										Key:  "host",
+										Path: ".status.host",
+									},
+									{},
+									{
+										Key:  "host",
+										Path: ".status.address",
+									},
+									{
+										Key:  "not/valid",
+										Path: ".spec[",
+									},
+									{
+										Key:  "password",
+										Path: ".spec.password",
+										SecretKeyRef: &ClusterServiceResourceMappingSecretKeyRef{
+											Name: ".spec.passwordSecretRef.name",
+											Key:  "password",
+										},
+									},
+									{
+										Key:          "username",
+										SecretKeyRef: &ClusterServiceResourceMappingSecretKeyRef{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(1).Child("key"), ""),
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(1), "one of path or secretKeyRef is required"),
+				field.Duplicate(field.NewPath("spec", "versions").Index(0).Child("secret", "entries", "[0, 2]", "key"), "host"),
+				field.Invalid(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(3).Child("key"), "not/valid", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+				field.Invalid(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(3).Child("path"), ".spec[", "unterminated array"),
+				field.Forbidden(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(4).Child("secretKeyRef"), "must not be set with path"),
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(5).Child("secretKeyRef", "name"), ""),
+				field.Required(field.NewPath("spec", "versions").Index(0).Child("secret", "entries").Index(5).Child("secretKeyRef", "key"), ""),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
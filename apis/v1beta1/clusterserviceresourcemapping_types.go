@@ -0,0 +1,95 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ClusterServiceResourceMappingTemplate defines the mapping for a specific version of a service resource to a binding
+// Secret.
+type ClusterServiceResourceMappingTemplate struct {
+	// Version is the version of the service resource that this mapping is for.
+	Version string `json:"version"`
+	// SecretName is a JSONPath that references the name of the binding Secret within the service resource. The Secret
+	// must be in the same namespace as the service. Mutually exclusive with Secret. Defaults to
+	// `.status.binding.name` when Secret is not set.
+	SecretName string `json:"secretName,omitempty"`
+	// Secret synthesises a binding Secret from fields of the service resource and entries of other Secrets the service
+	// references, for service resources that do not expose a binding Secret. Mutually exclusive with SecretName.
+	Secret *ClusterServiceResourceMappingSecret `json:"secret,omitempty"`
+}
+
+// ClusterServiceResourceMappingSecret defines the entries of a binding Secret that is synthesised for a service
+// resource.
+type ClusterServiceResourceMappingSecret struct {
+	// Entries is the collection of entries in the binding Secret.
+	Entries []ClusterServiceResourceMappingEntry `json:"entries"`
+}
+
+// ClusterServiceResourceMappingEntry defines the source of the value for a single binding Secret entry.
+type ClusterServiceResourceMappingEntry struct {
+	// Key is the key of the entry in the binding Secret.
+	Key string `json:"key"`
+	// Path is a JSONPath that references the value of the entry within the service resource. Mutually exclusive with
+	// SecretKeyRef.
+	Path string `json:"path,omitempty"`
+	// SecretKeyRef references the value of the entry within another Secret the service resource references. Mutually
+	// exclusive with Path.
+	SecretKeyRef *ClusterServiceResourceMappingSecretKeyRef `json:"secretKeyRef,omitempty"`
+}
+
+// ClusterServiceResourceMappingSecretKeyRef references an entry of a Secret in the same namespace as the service
+// resource.
+type ClusterServiceResourceMappingSecretKeyRef struct {
+	// Name is a JSONPath that references the name of the Secret within the service resource.
+	Name string `json:"name"`
+	// Key is the key of the entry within the referenced Secret.
+	Key string `json:"key"`
+}
+
+// ClusterServiceResourceMappingSpec defines the desired state of ClusterServiceResourceMapping
+type ClusterServiceResourceMappingSpec struct {
+	// Versions is the collection of versions for a given resource, with mappings.
+	Versions []ClusterServiceResourceMappingTemplate `json:"versions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterServiceResourceMapping is the Schema for the clusterserviceresourcemappings API. The name of the mapping is
+// the fully qualified resource of the service, like `databases.example.com`.
+type ClusterServiceResourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterServiceResourceMappingSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterServiceResourceMappingList contains a list of ClusterServiceResourceMapping
+type ClusterServiceResourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterServiceResourceMapping `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterServiceResourceMapping{}, &ClusterServiceResourceMappingList{})
+}
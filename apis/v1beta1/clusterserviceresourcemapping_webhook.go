@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ClusterServiceResourceMapping) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+var _ webhook.Defaulter = &ClusterServiceResourceMapping{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) Default() {
+	for i := range r.Spec.Versions {
+		r.Spec.Versions[i].Default()
+	}
+}
+
+// Default applies values that are appropriate for a Provisioned Service
+func (r *ClusterServiceResourceMappingTemplate) Default() {
+	if r.SecretName == "" && r.Secret == nil {
+		r.SecretName = ".status.binding.name"
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-clusterserviceresourcemapping,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterserviceresourcemappings,verbs=create;update,versions=v1beta1,name=vclusterserviceresourcemapping.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ClusterServiceResourceMapping{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateCreate() error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateUpdate(old runtime.Object) error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateDelete() error {
+	return nil
+}
+
+func (r *ClusterServiceResourceMapping) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	versions := map[string]int{}
+	for i := range r.Spec.Versions {
+		// check for duplicate versions
+		if p, ok := versions[r.Spec.Versions[i].Version]; ok {
+			errs = append(errs, field.Duplicate(field.NewPath("spec", "versions", fmt.Sprintf("[%d, %d]", p, i), "version"), r.Spec.Versions[i].Version))
+		}
+		versions[r.Spec.Versions[i].Version] = i
+		errs = append(errs, r.Spec.Versions[i].validate(field.NewPath("spec", "versions").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingTemplate) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Version == "" {
+		errs = append(errs, field.Required(fldPath.Child("version"), ""))
+	}
+	if r.Secret != nil {
+		if r.SecretName != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("secretName"), "must not be set with secret"))
+		}
+		errs = append(errs, r.Secret.validate(fldPath.Child("secret"))...)
+	} else if r.SecretName != "" {
+		// empty is defaulted
+		errs = append(errs, validateJsonPath(r.SecretName, fldPath.Child("secretName"))...)
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingSecret) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(r.Entries) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("entries"), ""))
+	}
+	keys := map[string]int{}
+	for i := range r.Entries {
+		errs = append(errs, r.Entries[i].validate(fldPath.Child("entries").Index(i))...)
+		// check for duplicate keys
+		if k := r.Entries[i].Key; k != "" {
+			if j, ok := keys[k]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("entries", fmt.Sprintf("[%d, %d]", j, i), "key"), k))
+			}
+			keys[k] = i
+		}
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingEntry) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Key == "" {
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	} else {
+		for _, msg := range validation.IsConfigMapKey(r.Key) {
+			errs = append(errs, field.Invalid(fldPath.Child("key"), r.Key, msg))
+		}
+	}
+	switch {
+	case r.Path != "" && r.SecretKeyRef != nil:
+		errs = append(errs, field.Forbidden(fldPath.Child("secretKeyRef"), "must not be set with path"))
+	case r.Path != "":
+		errs = append(errs, validateJsonPath(r.Path, fldPath.Child("path"))...)
+	case r.SecretKeyRef != nil:
+		errs = append(errs, r.SecretKeyRef.validate(fldPath.Child("secretKeyRef"))...)
+	default:
+		errs = append(errs, field.Required(fldPath, "one of path or secretKeyRef is required"))
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingSecretKeyRef) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		errs = append(errs, validateJsonPath(r.Name, fldPath.Child("name"))...)
+	}
+	if r.Key == "" {
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	}
+
+	return errs
+}
@@ -172,6 +172,77 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "templates defaults",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: ".spec.driver.template.metadata.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver.template.spec.containers[*]",
+											Name: ".name",
+										},
+									},
+									Volumes: ".spec.driver.template.spec.volumes",
+								},
+								{
+									Annotations: ".spec.executor.template.metadata.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path:         ".spec.executor.template.spec.containers[*]",
+											Name:         ".name",
+											Env:          ".envs",
+											VolumeMounts: ".mounts",
+										},
+									},
+									Volumes: ".spec.executor.template.spec.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: ".spec.driver.template.metadata.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path:         ".spec.driver.template.spec.containers[*]",
+											Name:         ".name",
+											Env:          ".env",
+											VolumeMounts: ".volumeMounts",
+										},
+									},
+									Volumes: ".spec.driver.template.spec.volumes",
+								},
+								{
+									Annotations: ".spec.executor.template.metadata.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path:         ".spec.executor.template.spec.containers[*]",
+											Name:         ".name",
+											Env:          ".envs",
+											VolumeMounts: ".mounts",
+										},
+									},
+									Volumes: ".spec.executor.template.spec.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "fully speced",
 			seed: &ClusterWorkloadResourceMapping{
@@ -410,6 +481,188 @@ func TestClusterWorkloadResourceMappingValidate(t *testing.T) {
 				field.Invalid(field.NewPath("spec.versions[0].volumes"), "..", "unsupported node: NodeRecursive"),
 			},
 		},
+		{
+			name: "templates are valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: ".spec.driver.template.metadata.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver.template.spec.containers[*]",
+										},
+									},
+									Volumes: ".spec.driver.template.spec.volumes",
+								},
+								{
+									Annotations:  ".spec.executor.template.metadata.annotations",
+									Volumes:      ".spec.executor.template.spec.volumes",
+									VolumesShape: ClusterWorkloadResourceMappingShapeMap,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "templates with pod template fields are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "*",
+							Annotations: ".spec.template.metadata.annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path: ".spec.template.spec.containers[*]",
+								},
+							},
+							Volumes:      ".spec.template.spec.volumes",
+							VolumesShape: ClusterWorkloadResourceMappingShapeList,
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: ".spec.driver.template.metadata.annotations",
+									Volumes:     ".spec.driver.template.spec.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.versions[0].annotations"), "must not be set with templates"),
+				field.Forbidden(field.NewPath("spec.versions[0].containers"), "must not be set with templates"),
+				field.Forbidden(field.NewPath("spec.versions[0].volumes"), "must not be set with templates"),
+				field.Forbidden(field.NewPath("spec.versions[0].volumesShape"), "must not be set with templates"),
+			},
+		},
+		{
+			name: "templates missing annotations and volumes are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec.versions[0].templates[0].annotations"), ""),
+				field.Required(field.NewPath("spec.versions[0].templates[0].volumes"), ""),
+			},
+		},
+		{
+			name: "templates with invalid fields",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: "..",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: "}{",
+										},
+									},
+									Volumes:      "..",
+									VolumesShape: "Set",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].templates[0].annotations"), "..", "unsupported node: NodeRecursive"),
+				field.Invalid(field.NewPath("spec.versions[0].templates[0].volumes"), "..", "unsupported node: NodeRecursive"),
+				field.NotSupported(field.NewPath("spec.versions[0].templates[0].volumesShape"), ClusterWorkloadResourceMappingShape("Set"), []string{"List", "Map"}),
+				field.Invalid(field.NewPath("spec.versions[0].templates[0].containers[0].path"), "}{", "too many root nodes"),
+			},
+		},
+		{
+			name: "templates sharing annotations or volumes are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Templates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: ".spec.template.metadata.annotations",
+									Volumes:     ".spec.template.spec.volumes",
+								},
+								{
+									Annotations: ".spec.template.metadata.annotations",
+									Volumes:     ".spec.template.spec.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec.versions[0].templates.[0, 1].annotations"), ".spec.template.metadata.annotations"),
+				field.Duplicate(field.NewPath("spec.versions[0].templates.[0, 1].volumes"), ".spec.template.spec.volumes"),
+			},
+		},
+		{
+			name: "list and map shapes are valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:              ".spec.containers[*]",
+									EnvShape:          ClusterWorkloadResourceMappingShapeMap,
+									VolumeMountsShape: ClusterWorkloadResourceMappingShapeList,
+								},
+							},
+							VolumesShape: ClusterWorkloadResourceMappingShapeMap,
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid shapes",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:              ".spec.containers[*]",
+									EnvShape:          "Set",
+									VolumeMountsShape: "Set",
+								},
+							},
+							VolumesShape: "Set",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.versions[0].volumesShape"), ClusterWorkloadResourceMappingShape("Set"), []string{"List", "Map"}),
+				field.NotSupported(field.NewPath("spec.versions[0].containers[0].envShape"), ClusterWorkloadResourceMappingShape("Set"), []string{"List", "Map"}),
+				field.NotSupported(field.NewPath("spec.versions[0].containers[0].volumeMountsShape"), ClusterWorkloadResourceMappingShape("Set"), []string{"List", "Map"}),
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -525,10 +778,34 @@ func TestValidateRestrictedJsonPath(t *testing.T) {
 			},
 		},
 		{
-			name:       "array",
+			name:       "array index",
 			expression: "[0]",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "nested array index",
+			expression: ".foo[1].bar['baz'][0]",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "negative array index",
+			expression: ".foo[-1]",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, ".foo[-1]", "unsupported node: NodeArray: [{-1 true false} {0 true true} {0 false false}], only a fixed non-negative array index is allowed"),
+			},
+		},
+		{
+			name:       "array slice",
+			expression: ".foo[0:2]",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, ".foo[0:2]", "unsupported node: NodeArray: [{0 true false} {2 true false} {0 false false}], only a fixed non-negative array index is allowed"),
+			},
+		},
+		{
+			name:       "array wildcard",
+			expression: ".foo[*]",
 			expected: field.ErrorList{
-				field.Invalid(fldPath, "[0]", "unsupported node: NodeArray: [{0 true false} {1 true true} {0 false false}]"),
+				field.Invalid(fldPath, ".foo[*]", "unsupported node: NodeArray: [{0 false false} {0 false false} {0 false false}], only a fixed non-negative array index is allowed"),
 			},
 		},
 		{
@@ -33,8 +33,45 @@ type ClusterWorkloadResourceMappingTemplate struct {
 	// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to
 	// `.spec.template.spec.volumes`.
 	Volumes string `json:"volumes,omitempty"`
+	// VolumesShape is the JSON structure of the volumes referenced by Volumes. Defaults to `List`.
+	VolumesShape ClusterWorkloadResourceMappingShape `json:"volumesShape,omitempty"`
+	// Templates is the collection of mappings to pod template-like fragments for workload resources that contain more
+	// than one pod template, like a driver and executors. The service binding is projected into each template
+	// independently. When defined, Annotations, Containers, Volumes and VolumesShape must be empty and are not
+	// defaulted.
+	Templates []ClusterWorkloadResourceMappingPodTemplate `json:"templates,omitempty"`
 }
 
+// ClusterWorkloadResourceMappingPodTemplate defines the mapping for one of many pod template-like fragments of a
+// workload resource.
+type ClusterWorkloadResourceMappingPodTemplate struct {
+	// Annotations is a Restricted JSONPath that references the annotations map within the workload resource for this
+	// pod template.
+	Annotations string `json:"annotations"`
+	// Containers is the collection of mappings to container-like fragments of the workload resource for this pod
+	// template.
+	Containers []ClusterWorkloadResourceMappingContainer `json:"containers,omitempty"`
+	// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource for this pod
+	// template.
+	Volumes string `json:"volumes"`
+	// VolumesShape is the JSON structure of the volumes referenced by Volumes. Defaults to `List`.
+	VolumesShape ClusterWorkloadResourceMappingShape `json:"volumesShape,omitempty"`
+}
+
+// ClusterWorkloadResourceMappingShape is the JSON structure of a collection of named items, like environment
+// variables or volumes, within the workload resource.
+// +kubebuilder:validation:Enum=List;Map
+type ClusterWorkloadResourceMappingShape string
+
+const (
+	// ClusterWorkloadResourceMappingShapeList is an array of objects, each with a `name` field, as used by a PodSpec.
+	ClusterWorkloadResourceMappingShapeList ClusterWorkloadResourceMappingShape = "List"
+	// ClusterWorkloadResourceMappingShapeMap is an object keyed by the name of each item. The value is the item without
+	// its `name` field. A string value is shorthand for an item with only a `value` field, which is common for
+	// environment variables.
+	ClusterWorkloadResourceMappingShapeMap ClusterWorkloadResourceMappingShape = "Map"
+)
+
 // ClusterWorkloadResourceMappingContainer defines the mapping for a specific fragment of an workload resource
 // to a Container-like structure.
 //
@@ -55,6 +92,10 @@ type ClusterWorkloadResourceMappingContainer struct {
 	// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
 	// to `.volumeMounts`.
 	VolumeMounts string `json:"volumeMounts,omitempty"`
+	// EnvShape is the JSON structure of the environment variables referenced by Env. Defaults to `List`.
+	EnvShape ClusterWorkloadResourceMappingShape `json:"envShape,omitempty"`
+	// VolumeMountsShape is the JSON structure of the volume mounts referenced by VolumeMounts. Defaults to `List`.
+	VolumeMountsShape ClusterWorkloadResourceMappingShape `json:"volumeMountsShape,omitempty"`
 }
 
 // ClusterWorkloadResourceMappingSpec defines the desired state of ClusterWorkloadResourceMapping
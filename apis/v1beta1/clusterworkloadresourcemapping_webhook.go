@@ -43,6 +43,13 @@ func (r *ClusterWorkloadResourceMapping) Default() {
 
 // Default applies values that are appropriate for a PodSpecable resource
 func (r *ClusterWorkloadResourceMappingTemplate) Default() {
+	if len(r.Templates) != 0 {
+		// the workload resource is not PodSpecable
+		for i := range r.Templates {
+			r.Templates[i].Default()
+		}
+		return
+	}
 	if r.Annotations == "" {
 		r.Annotations = ".spec.template.metadata.annotations"
 	}
@@ -59,19 +66,30 @@ func (r *ClusterWorkloadResourceMappingTemplate) Default() {
 		}
 	}
 	for i := range r.Containers {
-		c := &r.Containers[i]
-		if c.Env == "" {
-			c.Env = ".env"
-		}
-		if c.VolumeMounts == "" {
-			c.VolumeMounts = ".volumeMounts"
-		}
+		r.Containers[i].Default()
 	}
 	if r.Volumes == "" {
 		r.Volumes = ".spec.template.spec.volumes"
 	}
 }
 
+// Default applies values to each container, the annotations and volumes are required
+func (r *ClusterWorkloadResourceMappingPodTemplate) Default() {
+	for i := range r.Containers {
+		r.Containers[i].Default()
+	}
+}
+
+// Default applies values that are appropriate for a Container
+func (r *ClusterWorkloadResourceMappingContainer) Default() {
+	if r.Env == "" {
+		r.Env = ".env"
+	}
+	if r.VolumeMounts == "" {
+		r.VolumeMounts = ".volumeMounts"
+	}
+}
+
 //+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-clusterworkloadresourcemapping,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=create;update,versions={v1alpha3,v1beta1},name=vclusterworkloadresourcemapping.kb.io,admissionReviewVersions={v1,v1beta1}
 
 var _ webhook.Validator = &ClusterWorkloadResourceMapping{}
@@ -116,8 +134,63 @@ func (r *ClusterWorkloadResourceMappingTemplate) validate(fldPath *field.Path) f
 	if r.Version == "" {
 		errs = append(errs, field.Required(fldPath.Child("version"), ""))
 	}
+	if len(r.Templates) != 0 {
+		if r.Annotations != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("annotations"), "must not be set with templates"))
+		}
+		if len(r.Containers) != 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("containers"), "must not be set with templates"))
+		}
+		if r.Volumes != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("volumes"), "must not be set with templates"))
+		}
+		if r.VolumesShape != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("volumesShape"), "must not be set with templates"))
+		}
+
+		annotations := map[string]int{}
+		volumes := map[string]int{}
+		for i := range r.Templates {
+			t := r.Templates[i]
+			// templates must be independent of each other
+			if p, ok := annotations[t.Annotations]; ok && t.Annotations != "" {
+				errs = append(errs, field.Duplicate(fldPath.Child("templates", fmt.Sprintf("[%d, %d]", p, i), "annotations"), t.Annotations))
+			}
+			annotations[t.Annotations] = i
+			if p, ok := volumes[t.Volumes]; ok && t.Volumes != "" {
+				errs = append(errs, field.Duplicate(fldPath.Child("templates", fmt.Sprintf("[%d, %d]", p, i), "volumes"), t.Volumes))
+			}
+			volumes[t.Volumes] = i
+			errs = append(errs, t.validate(fldPath.Child("templates").Index(i))...)
+		}
+
+		return errs
+	}
+
 	errs = append(errs, validateRestrictedJsonPath(r.Annotations, fldPath.Child("annotations"))...)
 	errs = append(errs, validateRestrictedJsonPath(r.Volumes, fldPath.Child("volumes"))...)
+	errs = append(errs, validateShape(r.VolumesShape, fldPath.Child("volumesShape"))...)
+	for i := range r.Containers {
+		errs = append(errs, r.Containers[i].validate(fldPath.Child("containers").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ClusterWorkloadResourceMappingPodTemplate) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Annotations == "" {
+		errs = append(errs, field.Required(fldPath.Child("annotations"), ""))
+	} else {
+		errs = append(errs, validateRestrictedJsonPath(r.Annotations, fldPath.Child("annotations"))...)
+	}
+	if r.Volumes == "" {
+		errs = append(errs, field.Required(fldPath.Child("volumes"), ""))
+	} else {
+		errs = append(errs, validateRestrictedJsonPath(r.Volumes, fldPath.Child("volumes"))...)
+	}
+	errs = append(errs, validateShape(r.VolumesShape, fldPath.Child("volumesShape"))...)
 	for i := range r.Containers {
 		errs = append(errs, r.Containers[i].validate(fldPath.Child("containers").Index(i))...)
 	}
@@ -135,6 +208,8 @@ func (r *ClusterWorkloadResourceMappingContainer) validate(fldPath *field.Path)
 	}
 	errs = append(errs, validateRestrictedJsonPath(r.Env, fldPath.Child("env"))...)
 	errs = append(errs, validateRestrictedJsonPath(r.VolumeMounts, fldPath.Child("volumeMounts"))...)
+	errs = append(errs, validateShape(r.EnvShape, fldPath.Child("envShape"))...)
+	errs = append(errs, validateShape(r.VolumeMountsShape, fldPath.Child("volumeMountsShape"))...)
 
 	return errs
 }
@@ -153,6 +228,22 @@ func validateJsonPath(expression string, fldPath *field.Path) field.ErrorList {
 	return errs
 }
 
+func validateShape(shape ClusterWorkloadResourceMappingShape, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	switch shape {
+	case "", ClusterWorkloadResourceMappingShapeList, ClusterWorkloadResourceMappingShapeMap:
+		// valid, empty defaults to a list
+	default:
+		errs = append(errs, field.NotSupported(fldPath, shape, []string{
+			string(ClusterWorkloadResourceMappingShapeList),
+			string(ClusterWorkloadResourceMappingShapeMap),
+		}))
+	}
+
+	return errs
+}
+
 func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -162,7 +253,7 @@ func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.Er
 		if len(p.Root.Nodes) != 1 {
 			errs = append(errs, field.Invalid(fldPath, expression, "too many root nodes"))
 		}
-		// only allow jsonpath.NodeField nodes
+		// only allow jsonpath.NodeField nodes and jsonpath.NodeArray nodes with a fixed index
 		nodes := p.Root.Nodes
 		for i := 0; i < len(nodes); i++ {
 			switch n := nodes[i].(type) {
@@ -170,6 +261,10 @@ func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.Er
 				nodes = append(nodes, n.Nodes...)
 			case *jsonpath.FieldNode:
 				continue
+			case *jsonpath.ArrayNode:
+				if !isFixedArrayIndex(n) {
+					errs = append(errs, field.Invalid(fldPath, expression, fmt.Sprintf("unsupported node: %s, only a fixed non-negative array index is allowed", n)))
+				}
 			default:
 				errs = append(errs, field.Invalid(fldPath, expression, fmt.Sprintf("unsupported node: %s", n)))
 			}
@@ -178,3 +273,10 @@ func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.Er
 
 	return errs
 }
+
+// isFixedArrayIndex returns true when the node references a single, non-negative index like `[0]`, rather than a slice
+// or wildcard.
+func isFixedArrayIndex(n *jsonpath.ArrayNode) bool {
+	start, end, step := n.Params[0], n.Params[1], n.Params[2]
+	return start.Known && start.Value >= 0 && end.Derived && !step.Known
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 )
 
 func TestServiceBindingDefault(t *testing.T) {
@@ -46,6 +47,29 @@ func TestServiceBindingDefault(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "no default name for services",
+			seed: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingSpec{
+					Services: []ServiceBindingServiceEntry{
+						{Name: "db"},
+					},
+				},
+			},
+			expected: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingSpec{
+					Services: []ServiceBindingServiceEntry{
+						{Name: "db"},
+					},
+				},
+			},
+		},
 		{
 			name: "preserve name",
 			seed: &ServiceBinding{
@@ -91,7 +115,7 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "name"), ""),
 				field.Required(field.NewPath("spec", "service", "apiVersion"), ""),
 				field.Required(field.NewPath("spec", "service", "kind"), ""),
-				field.Required(field.NewPath("spec", "service", "name"), ""),
+				field.Required(field.NewPath("spec", "service", "[name, selector]"), "expected exactly one, got neither"),
 				field.Required(field.NewPath("spec", "workload", "apiVersion"), ""),
 				field.Required(field.NewPath("spec", "workload", "kind"), ""),
 				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got neither"),
@@ -116,6 +140,48 @@ func TestServiceBindingValidate(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "service valid namespace",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+						Namespace:  "my-services",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "service invalid namespace",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+						Namespace:  "My_Services",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "service", "namespace"), "My_Services", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
 		{
 			name: "workload valid selector",
 			seed: &ServiceBinding{
@@ -168,6 +234,82 @@ func TestServiceBindingValidate(t *testing.T) {
 				}, `"NotAnOperator" is not a valid pod selector operator`),
 			},
 		},
+		{
+			name: "service valid selector",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyProvisionedService",
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "my-database"},
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "service invalid selector",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyProvisionedService",
+						Selector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{
+								Key:      "foo",
+								Operator: "NotAnOperator",
+								Values:   []string{"bar"},
+							}},
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "service", "selector"), &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "foo",
+						Operator: "NotAnOperator",
+						Values:   []string{"bar"},
+					}},
+				}, `"NotAnOperator" is not a valid pod selector operator`),
+			},
+		},
+		{
+			name: "service invalid overspeced",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyProvisionedService",
+						Name:       "my-service",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "service", "[name, selector]"), "expected exactly one, got both"),
+			},
+		},
 		{
 			name: "workload invalid overspeced",
 			seed: &ServiceBinding{
@@ -246,6 +388,574 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "env[1]", "key"), ""),
 			},
 		},
+		{
+			name: "workload valid env template",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Env: []EnvMapping{
+						{
+							Name:     "DATABASE_URL",
+							Template: "postgres://{{ .username }}:{{ .password }}@{{ .host }}:{{ .port }}/{{ .database }}",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid env template",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Env: []EnvMapping{
+						{
+							Name:     "DATABASE_URL",
+							Key:      "url",
+							Template: "postgres://{{ .host }",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "env[0]", "key"), "must not be set with template"),
+				field.Invalid(field.NewPath("spec", "env[0]", "template"), "postgres://{{ .host }", `template: DATABASE_URL:1: unexpected "}" in operand`),
+			},
+		},
+		{
+			name: "workload duplicate env name",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Env: []EnvMapping{
+						{
+							Name: "VAR_NAME",
+							Key:  "secret-key",
+						},
+						{
+							Name: "OTHER_VAR_NAME",
+							Key:  "secret-key",
+						},
+						{
+							Name: "VAR_NAME",
+							Key:  "other-secret-key",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "env", "[0, 2]", "name"), "VAR_NAME"),
+			},
+		},
+		{
+			name: "workload valid env from",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					EnvFrom: &EnvFromMapping{
+						Prefix: "DB_",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid env from",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					EnvFrom: &EnvFromMapping{
+						Prefix: "1-DB",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "envFrom", "prefix"), "1-DB", "must be a valid C identifier"),
+			},
+		},
+		{
+			name: "workload valid volume",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Volume: &VolumeOptions{
+						MountPath:   "/etc/nginx/certs",
+						DefaultMode: pointer.Int32(0400),
+						ReadOnly:    pointer.Bool(false),
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid volume",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Volume: &VolumeOptions{
+						MountPath:   "etc/nginx/certs",
+						DefaultMode: pointer.Int32(01000),
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "volume", "mountPath"), "etc/nginx/certs", "must be an absolute path"),
+				field.Invalid(field.NewPath("spec", "volume", "defaultMode"), int32(01000), "must be a value between 0 and 0777 (octal), both inclusive"),
+			},
+		},
+		{
+			name: "workload unclean volume mount path",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Volume: &VolumeOptions{
+						MountPath: "/etc/../certs/",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "volume", "mountPath"), "/etc/../certs/", "must be a clean path"),
+			},
+		},
+		{
+			name: "workload consolidated volume",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Volume: &VolumeOptions{
+						DefaultMode:  pointer.Int32(0400),
+						Consolidated: true,
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid consolidated volume",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+						Containers: []string{"my-container"},
+					},
+					Volume: &VolumeOptions{
+						MountPath:    "/etc/nginx/certs",
+						ReadOnly:     pointer.Bool(false),
+						Consolidated: true,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "volume", "mountPath"), "must not be set with consolidated"),
+				field.Forbidden(field.NewPath("spec", "volume", "readOnly"), "must not be set with consolidated"),
+				field.Forbidden(field.NewPath("spec", "workload", "containers"), "must not be set with consolidated volume"),
+			},
+		},
+		{
+			name: "workload valid files",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Files: []FileMapping{
+						{
+							Key:  "username",
+							Path: "credentials/user",
+						},
+						{
+							Key: "password",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid files",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Type: "mysql",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Files: []FileMapping{
+						{
+							// missing fields
+						},
+						{
+							Key:  "username",
+							Path: "/etc/user",
+						},
+						{
+							Key:  "password",
+							Path: "../password",
+						},
+						{
+							Key:  "host",
+							Path: "password/../../host",
+						},
+						{
+							Key: "password",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "files[0]", "key"), ""),
+				field.Invalid(field.NewPath("spec", "files[1]", "path"), "/etc/user", "must be a relative path"),
+				field.Invalid(field.NewPath("spec", "files[2]", "path"), "../password", "must not contain '..'"),
+				field.Invalid(field.NewPath("spec", "files[3]", "path"), "password/../../host", "must not contain '..'"),
+			},
+		},
+		{
+			name: "workload duplicate files",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Files: []FileMapping{
+						{
+							Key: "password",
+						},
+						{
+							Key:  "username",
+							Path: "user",
+						},
+						{
+							Key:  "pass",
+							Path: "password",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "files", "[0, 2]", "path"), "password"),
+			},
+		},
+		{
+			name: "workload invalid file keys",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Files: []FileMapping{
+						{
+							Key: "..",
+						},
+						{
+							Key: "/etc/password",
+						},
+						{
+							Key:  "..",
+							Path: "parent",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "files[0]", "key"), "..", "must not contain '..'"),
+				field.Invalid(field.NewPath("spec", "files[1]", "key"), "/etc/password", "must be a relative path"),
+			},
+		},
+		{
+			name: "workload conflicting file paths",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Files: []FileMapping{
+						{
+							Key: "credentials",
+						},
+						{
+							Key:  "username",
+							Path: "credentials/user",
+						},
+						{
+							Key:  "password",
+							Path: "credentials/password",
+						},
+						{
+							Key:  "host",
+							Path: "./credentials",
+						},
+						{
+							Key:  "port",
+							Path: "credentials-port",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "files", "[0, 1]", "path"), "credentials/user", `conflicts with the path "credentials"`),
+				field.Invalid(field.NewPath("spec", "files", "[0, 2]", "path"), "credentials/password", `conflicts with the path "credentials"`),
+				field.Duplicate(field.NewPath("spec", "files", "[0, 3]", "path"), "credentials"),
+				field.Invalid(field.NewPath("spec", "files", "[1, 3]", "path"), "credentials", `conflicts with the path "credentials/user"`),
+				field.Invalid(field.NewPath("spec", "files", "[2, 3]", "path"), "credentials", `conflicts with the path "credentials/password"`),
+			},
+		},
+		{
+			name: "services valid",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Services: []ServiceBindingServiceEntry{
+						{
+							Name: "db",
+							Type: "postgresql",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-database",
+							},
+							Env: []EnvMapping{
+								{
+									Name: "DB_HOST",
+									Key:  "host",
+								},
+							},
+						},
+						{
+							Name: "cache",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-cache",
+							},
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "services invalid",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name:     "my-binding",
+					Type:     "postgresql",
+					Provider: "bitnami",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Services: []ServiceBindingServiceEntry{
+						{
+							Name: "db",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-database",
+							},
+							Env: []EnvMapping{
+								{
+									Name: "DB_HOST",
+								},
+							},
+						},
+						{
+							Name: "db",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+							},
+						},
+						{
+							Name: "My_Cache",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-cache",
+							},
+						},
+						{},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Env: []EnvMapping{
+						{
+							Name: "HOST",
+							Key:  "host",
+						},
+					},
+					Files: []FileMapping{
+						{
+							Key: "password",
+						},
+					},
+					Volume: &VolumeOptions{
+						MountPath: "/bindings/db",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "[service, services]"), "expected exactly one, got both"),
+				field.Forbidden(field.NewPath("spec", "name"), "must not be set with services"),
+				field.Forbidden(field.NewPath("spec", "type"), "must not be set with services"),
+				field.Forbidden(field.NewPath("spec", "provider"), "must not be set with services"),
+				field.Forbidden(field.NewPath("spec", "env"), "must not be set with services"),
+				field.Forbidden(field.NewPath("spec", "files"), "must not be set with services"),
+				field.Forbidden(field.NewPath("spec", "volume", "mountPath"), "must not be set with services"),
+				field.Required(field.NewPath("spec", "services[0]", "env[0]", "key"), ""),
+				field.Required(field.NewPath("spec", "services[1]", "service", "[name, selector]"), "expected exactly one, got neither"),
+				field.Duplicate(field.NewPath("spec", "services", "[0, 1]", "name"), "db"),
+				field.Invalid(field.NewPath("spec", "services[2]", "name"), "My_Cache", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+				field.Required(field.NewPath("spec", "services[3]", "name"), ""),
+				field.Required(field.NewPath("spec", "services[3]", "service", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "services[3]", "service", "kind"), ""),
+				field.Required(field.NewPath("spec", "services[3]", "service", "[name, selector]"), "expected exactly one, got neither"),
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // ServiceBindingWorkloadReference defines a subset of corev1.ObjectReference with extensions
@@ -43,9 +44,36 @@ type ServiceBindingServiceReference struct {
 	// Kind of the referent.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
 	Kind string `json:"kind"`
-	// Name of the referent.
+	// Name of the referent. Mutually exclusive with Selector.
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	Name string `json:"name,omitempty"`
+	// Selector is a query that selects the service by its labels. Mutually exclusive with Name. When several services
+	// are selected, the service with the highest integer `servicebinding.io/service-priority` annotation is bound. A
+	// service without the annotation has a priority of 0. Services that tie for the highest priority are not bound.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Namespace of the referent. Defaults to the namespace of the ServiceBinding. A service in another namespace must be
+	// granted to the ServiceBinding by a ServiceBindingGrant in the service's namespace, the binding Secret is
+	// replicated into the namespace of the ServiceBinding.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ServicePriorityAnnotation is the annotation on a service that breaks a tie between several services selected by a
+// service reference. The service with the highest integer priority is bound.
+const ServicePriorityAnnotation = "servicebinding.io/service-priority"
+
+// ServiceBindingServiceEntry defines one of several services bound by a ServiceBinding. Each service is projected into
+// the workload independently of the others.
+type ServiceBindingServiceEntry struct {
+	// Name is the name of the service as projected into the workload container. Must be unique within the binding.
 	Name string `json:"name"`
+	// Type is the type of the service as projected into the workload container
+	Type string `json:"type,omitempty"`
+	// Provider is the provider of the service as projected into the workload container
+	Provider string `json:"provider,omitempty"`
+	// Service is a reference to an object that fulfills the ProvisionedService duck type
+	Service ServiceBindingServiceReference `json:"service"`
+	// Env is the collection of mappings from the service's Secret entries to environment variables
+	Env []EnvMapping `json:"env,omitempty"`
 }
 
 // ServiceBindingSecretReference defines a mirror of corev1.LocalObjectReference
@@ -53,30 +81,106 @@ type ServiceBindingSecretReference struct {
 	// Name of the referent secret.
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
 	Name string `json:"name"`
+	// Keys are the entries within the referent secret. Only resolved when every entry is projected as an environment
+	// variable, or into a consolidated volume.
+	Keys []string `json:"keys,omitempty"`
+	// Hash is a digest of the content of the referent secret. Only resolved when workloads are rolled out on rotation.
+	Hash string `json:"hash,omitempty"`
+	// Type is the effective type of the binding. The type defined by the spec takes precedence over the `type` entry of
+	// the referent secret, which takes precedence over a referent secret of type `servicebinding.io/<type>`.
+	Type string `json:"type,omitempty"`
+	// Provider is the effective provider of the binding. The provider defined by the spec takes precedence over the
+	// `provider` entry of the referent secret.
+	Provider string `json:"provider,omitempty"`
+	// Env is the collection of default mappings from Secret entries to environment variables defined by the
+	// ClusterBindingTypeProfile for the binding's type. A mapping within the spec for the same environment variable
+	// takes precedence.
+	Env []EnvMapping `json:"env,omitempty"`
 }
 
-// EnvMapping defines a mapping from the value of a Secret entry to an environment variable
+// EnvMapping defines a mapping from the value of a Secret entry, or a value rendered from several Secret entries, to an
+// environment variable
 type EnvMapping struct {
 	// Name is the name of the environment variable
 	Name string `json:"name"`
+	// Key is the key in the Secret that will be exposed. Mutually exclusive with Template.
+	Key string `json:"key,omitempty"`
+	// Template renders the value of the environment variable from the entries in the Secret using Go text/template
+	// syntax, like `postgres://{{ .username }}:{{ .password }}@{{ .host }}:{{ .port }}/{{ .database }}`. The rendered
+	// value is stored in a Secret owned by the ServiceBinding. Mutually exclusive with Key.
+	Template string `json:"template,omitempty"`
+}
+
+// EnvFromMapping defines the projection of every Secret entry as an environment variable
+type EnvFromMapping struct {
+	// Prefix is prepended to the key of each Secret entry to form the name of the environment variable
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// FileMapping defines a mapping from the value of a Secret entry to a file within the binding volume
+type FileMapping struct {
 	// Key is the key in the Secret that will be exposed
 	Key string `json:"key"`
+	// Path is the relative path of the file within the binding volume. Defaults to the key.
+	Path string `json:"path,omitempty"`
+}
+
+// VolumeOptions defines overrides for the projected binding volume
+type VolumeOptions struct {
+	// MountPath is the absolute path within the container the binding volume is mounted at. Defaults to the binding name
+	// within `$SERVICE_BINDING_ROOT`.
+	MountPath string `json:"mountPath,omitempty"`
+	// DefaultMode is the mode bits used to set permissions on the projected files. Must be a value between 0 and 0777.
+	// Defaults to the Kubernetes default for projected volumes.
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
+	// ReadOnly mounts the binding volume read-only. Defaults to true.
+	ReadOnly *bool `json:"readOnly,omitempty"`
+	// Consolidated projects the binding into a single projected volume that is shared by every consolidated binding for
+	// the workload, rather than a volume per binding. The binding's entries are projected into the binding name directory
+	// of the shared volume, which is mounted at `$SERVICE_BINDING_ROOT`. MountPath, ReadOnly and the workload's
+	// containers must not be set.
+	Consolidated bool `json:"consolidated,omitempty"`
 }
 
 // ServiceBindingSpec defines the desired state of ServiceBinding
 type ServiceBindingSpec struct {
-	// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
+	// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name. Must not be
+	// set with Services.
 	Name string `json:"name,omitempty"`
-	// Type is the type of the service as projected into the workload container
+	// Type is the type of the service as projected into the workload container. Must not be set with Services.
 	Type string `json:"type,omitempty"`
-	// Provider is the provider of the service as projected into the workload container
+	// Provider is the provider of the service as projected into the workload container. Must not be set with Services.
 	Provider string `json:"provider,omitempty"`
 	// Workload is a reference to an object
 	Workload ServiceBindingWorkloadReference `json:"workload"`
-	// Service is a reference to an object that fulfills the ProvisionedService duck type
-	Service ServiceBindingServiceReference `json:"service"`
-	// Env is the collection of mappings from Secret entries to environment variables
+	// Service is a reference to an object that fulfills the ProvisionedService duck type. Mutually exclusive with
+	// Services.
+	Service ServiceBindingServiceReference `json:"service,omitempty"`
+	// Services is the collection of services bound to the workload, each with its own name, type, provider and env
+	// mappings. The remaining options of the binding apply to every service. Mutually exclusive with Service.
+	Services []ServiceBindingServiceEntry `json:"services,omitempty"`
+	// Env is the collection of mappings from Secret entries to environment variables. Must not be set with Services.
 	Env []EnvMapping `json:"env,omitempty"`
+	// EnvFrom projects every Secret entry as an environment variable. Variable names are the prefix and key, upper cased
+	// with characters that are not valid in a C identifier replaced by an underscore. Mappings in Env take precedence.
+	EnvFrom *EnvFromMapping `json:"envFrom,omitempty"`
+	// Files is the collection of Secret entries projected into the binding volume. When empty, every entry in the
+	// Secret is projected as a file named by its key. Must not be set with Services.
+	Files []FileMapping `json:"files,omitempty"`
+	// RolloutOnRotation stamps a hash of the content of the Secret into the workload's pod template, so that the workload
+	// is rolled out when the Secret is rotated without being renamed.
+	RolloutOnRotation bool `json:"rolloutOnRotation,omitempty"`
+	// Volume overrides how the binding volume is projected into the workload
+	Volume *VolumeOptions `json:"volume,omitempty"`
+	// RequireServiceReady holds back projecting the binding until the service reports a Ready condition that is True.
+	// The ServiceAvailable condition of the binding mirrors the status, reason and message of the service's Ready
+	// condition until then. A binding that is already projected is left as is. Ignored for a Secret referenced
+	// directly.
+	RequireServiceReady bool `json:"requireServiceReady,omitempty"`
+	// RecreateUnstartedJobs deletes and recreates, as projected, a Job that cannot be updated as its pod template is
+	// immutable, as long as the Job has not started any pods and is not suspended. Otherwise the Job is left as is, the
+	// service is projected into a Job when it is created.
+	RecreateUnstartedJobs bool `json:"recreateUnstartedJobs,omitempty"`
 }
 
 // ServiceBindingStatus defines the observed state of ServiceBinding
@@ -90,14 +194,75 @@ type ServiceBindingStatus struct {
 
 	// Binding exposes the projected secret for this ServiceBinding
 	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
+
+	// Services is the observed state of each service bound by a ServiceBinding that defines Services. The
+	// ServiceAvailable condition of the ServiceBinding is only True when every service is available.
+	Services []ServiceBindingServiceStatus `json:"services,omitempty"`
+
+	// Workloads are the workloads the service is bound to. A workload that is no longer referenced by the
+	// ServiceBinding, like a workload whose labels no longer match the selector, is unprojected.
+	Workloads []ServiceBindingWorkloadStatus `json:"workloads,omitempty"`
+
+	// WorkloadCount is the number of Workloads
+	WorkloadCount int32 `json:"workloadCount,omitempty"`
+}
+
+// ServiceBindingServiceStatus defines the observed state of one of several services bound by a ServiceBinding
+type ServiceBindingServiceStatus struct {
+	// Name is the name of the service as projected into the workload container
+	Name string `json:"name"`
+
+	// Conditions are the conditions of the service, only the ServiceAvailable condition is reported
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Binding exposes the projected secret for the service
+	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
 }
 
+// ServiceBindingWorkloadStatus defines a workload the service is bound to
+type ServiceBindingWorkloadStatus struct {
+	// API version of the workload
+	APIVersion string `json:"apiVersion"`
+	// Kind of the workload
+	Kind string `json:"kind"`
+	// Namespace of the workload. Only set for a ClusterServiceBinding.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the workload
+	Name string `json:"name"`
+	// UID of the workload, a workload that is recreated with the same name is a different workload
+	UID types.UID `json:"uid"`
+	// ObservedGeneration is the 'Generation' of the workload when the service was last projected into it
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Result of projecting the service into the workload
+	Result ServiceBindingWorkloadResult `json:"result,omitempty"`
+	// Reason for the result, like `ProjectionCollision` when the workload is left as is
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of a failed projection
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceBindingWorkloadResult is the outcome of projecting the service into a workload
+// +kubebuilder:validation:Enum=Projected;Skipped;Failed
+type ServiceBindingWorkloadResult string
+
+const (
+	// ServiceBindingWorkloadResultProjected the service is projected into the workload
+	ServiceBindingWorkloadResultProjected ServiceBindingWorkloadResult = "Projected"
+	// ServiceBindingWorkloadResultSkipped the workload cannot be updated, like a Job whose pod template is immutable,
+	// the reason and message describe why
+	ServiceBindingWorkloadResultSkipped ServiceBindingWorkloadResult = "Skipped"
+	// ServiceBindingWorkloadResultFailed the service could not be projected into the workload, the reason and message
+	// describe why
+	ServiceBindingWorkloadResultFailed ServiceBindingWorkloadResult = "Failed"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.status.binding.name`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,JSONPath=`.status.workloadCount`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ServiceBinding is the Schema for the servicebindings API
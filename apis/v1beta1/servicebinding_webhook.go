@@ -17,8 +17,15 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -34,7 +41,7 @@ var _ webhook.Defaulter = &ServiceBinding{}
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *ServiceBinding) Default() {
-	if r.Spec.Name == "" {
+	if r.Spec.Name == "" && len(r.Spec.Services) == 0 {
 		r.Spec.Name = r.Name
 	}
 }
@@ -72,13 +79,125 @@ func (r *ServiceBinding) validate() field.ErrorList {
 func (r *ServiceBindingSpec) validate(fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
+	if len(r.Services) == 0 {
+		if r.Name == "" {
+			errs = append(errs, field.Required(fldPath.Child("name"), ""))
+		}
+		errs = append(errs, r.Service.validate(fldPath.Child("service"))...)
+	} else {
+		errs = append(errs, r.validateServices(fldPath)...)
+	}
+	errs = append(errs, r.Workload.validate(fldPath.Child("workload"))...)
+	envNames := map[string]int{}
+	for i := range r.Env {
+		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
+		// check for duplicate names
+		if n := r.Env[i].Name; n != "" {
+			if j, ok := envNames[n]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("env", fmt.Sprintf("[%d, %d]", j, i), "name"), n))
+			}
+			envNames[n] = i
+		}
+	}
+	if r.EnvFrom != nil {
+		errs = append(errs, r.EnvFrom.validate(fldPath.Child("envFrom"))...)
+	}
+	if r.Volume != nil {
+		errs = append(errs, r.Volume.validate(fldPath.Child("volume"))...)
+		if r.Volume.Consolidated && len(r.Workload.Containers) != 0 {
+			// the shared volume exposes every consolidated binding to each container it is mounted in
+			errs = append(errs, field.Forbidden(fldPath.Child("workload", "containers"), "must not be set with consolidated volume"))
+		}
+	}
+	paths := make([]string, len(r.Files))
+	for i := range r.Files {
+		errs = append(errs, r.Files[i].validate(fldPath.Child("files").Index(i))...)
+		p := r.Files[i].Path
+		if p == "" {
+			p = r.Files[i].Key
+		}
+		if p == "" {
+			continue
+		}
+		p = path.Clean(p)
+		// check for duplicate paths, and paths that are a directory of another file
+		for j := 0; j < i; j++ {
+			switch o := paths[j]; {
+			case o == p:
+				errs = append(errs, field.Duplicate(fldPath.Child("files", fmt.Sprintf("[%d, %d]", j, i), "path"), p))
+			case o != "" && (strings.HasPrefix(o, p+"/") || strings.HasPrefix(p, o+"/")):
+				errs = append(errs, field.Invalid(fldPath.Child("files", fmt.Sprintf("[%d, %d]", j, i), "path"), p, fmt.Sprintf("conflicts with the path %q", o)))
+			}
+		}
+		paths[i] = p
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingSpec) validateServices(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Service != (ServiceBindingServiceReference{}) {
+		errs = append(errs, field.Required(fldPath.Child("[service, services]"), "expected exactly one, got both"))
+	}
+	// options of a single service are defined by each entry
+	if r.Name != "" {
+		errs = append(errs, field.Forbidden(fldPath.Child("name"), "must not be set with services"))
+	}
+	if r.Type != "" {
+		errs = append(errs, field.Forbidden(fldPath.Child("type"), "must not be set with services"))
+	}
+	if r.Provider != "" {
+		errs = append(errs, field.Forbidden(fldPath.Child("provider"), "must not be set with services"))
+	}
+	if len(r.Env) != 0 {
+		errs = append(errs, field.Forbidden(fldPath.Child("env"), "must not be set with services"))
+	}
+	if len(r.Files) != 0 {
+		errs = append(errs, field.Forbidden(fldPath.Child("files"), "must not be set with services"))
+	}
+	if r.Volume != nil && r.Volume.MountPath != "" {
+		// each service is mounted within $SERVICE_BINDING_ROOT by name
+		errs = append(errs, field.Forbidden(fldPath.Child("volume", "mountPath"), "must not be set with services"))
+	}
+	names := map[string]int{}
+	for i := range r.Services {
+		errs = append(errs, r.Services[i].validate(fldPath.Child("services").Index(i))...)
+		// check for duplicate names
+		if n := r.Services[i].Name; n != "" {
+			if j, ok := names[n]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("services", fmt.Sprintf("[%d, %d]", j, i), "name"), n))
+			}
+			names[n] = i
+		}
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingServiceEntry) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
 	if r.Name == "" {
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		// the name is part of the names of the resources projected for the service
+		for _, msg := range validation.IsDNS1123Label(r.Name) {
+			errs = append(errs, field.Invalid(fldPath.Child("name"), r.Name, msg))
+		}
 	}
 	errs = append(errs, r.Service.validate(fldPath.Child("service"))...)
-	errs = append(errs, r.Workload.validate(fldPath.Child("workload"))...)
+	envNames := map[string]int{}
 	for i := range r.Env {
 		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
+		// check for duplicate names
+		if n := r.Env[i].Name; n != "" {
+			if j, ok := envNames[n]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("env", fmt.Sprintf("[%d, %d]", j, i), "name"), n))
+			}
+			envNames[n] = i
+		}
 	}
 
 	return errs
@@ -93,8 +212,21 @@ func (r *ServiceBindingServiceReference) validate(fldPath *field.Path) field.Err
 	if r.Kind == "" {
 		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
 	}
-	if r.Name == "" {
-		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	if r.Name == "" && r.Selector == nil {
+		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got neither"))
+	}
+	if r.Name != "" && r.Selector != nil {
+		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got both"))
+	}
+	if r.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Selector); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("selector"), r.Selector, err.Error()))
+		}
+	}
+	if r.Namespace != "" {
+		for _, msg := range validation.IsDNS1123Label(r.Namespace) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, msg))
+		}
 	}
 
 	return errs
@@ -130,9 +262,80 @@ func (r *EnvMapping) validate(fldPath *field.Path) field.ErrorList {
 	if r.Name == "" {
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
+	if r.Template != "" {
+		if r.Key != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("key"), "must not be set with template"))
+		}
+		if _, err := template.New(r.Name).Parse(r.Template); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("template"), r.Template, err.Error()))
+		}
+	} else if r.Key == "" {
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	}
+
+	return errs
+}
+
+var envPrefixRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (r *EnvFromMapping) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Prefix != "" && !envPrefixRegexp.MatchString(r.Prefix) {
+		errs = append(errs, field.Invalid(fldPath.Child("prefix"), r.Prefix, "must be a valid C identifier"))
+	}
+
+	return errs
+}
+
+func (r *VolumeOptions) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.MountPath != "" {
+		if !path.IsAbs(r.MountPath) {
+			errs = append(errs, field.Invalid(fldPath.Child("mountPath"), r.MountPath, "must be an absolute path"))
+		} else if path.Clean(r.MountPath) != r.MountPath {
+			errs = append(errs, field.Invalid(fldPath.Child("mountPath"), r.MountPath, "must be a clean path"))
+		}
+	}
+	if r.DefaultMode != nil && (*r.DefaultMode < 0 || *r.DefaultMode > 0777) {
+		errs = append(errs, field.Invalid(fldPath.Child("defaultMode"), *r.DefaultMode, "must be a value between 0 and 0777 (octal), both inclusive"))
+	}
+	if r.Consolidated {
+		// the shared volume is mounted once at $SERVICE_BINDING_ROOT
+		if r.MountPath != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("mountPath"), "must not be set with consolidated"))
+		}
+		if r.ReadOnly != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("readOnly"), "must not be set with consolidated"))
+		}
+	}
+
+	return errs
+}
+
+func (r *FileMapping) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
 	if r.Key == "" {
 		errs = append(errs, field.Required(fldPath.Child("key"), ""))
 	}
+	// the key is the path of the file when the path is not set
+	p, pPath := r.Path, fldPath.Child("path")
+	if p == "" {
+		p, pPath = r.Key, fldPath.Child("key")
+	}
+	if p != "" {
+		if path.IsAbs(p) {
+			errs = append(errs, field.Invalid(pPath, p, "must be a relative path"))
+		}
+		for _, element := range strings.Split(p, "/") {
+			if element == ".." {
+				errs = append(errs, field.Invalid(pPath, p, "must not contain '..'"))
+				break
+			}
+		}
+	}
 
 	return errs
 }
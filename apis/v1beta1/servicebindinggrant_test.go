@@ -0,0 +1,243 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestServiceBindingGrantValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingGrant
+		expected field.ErrorList
+	}{
+		{
+			name: "empty",
+			seed: &ServiceBindingGrant{},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "from"), ""),
+				field.Required(field.NewPath("spec", "to"), ""),
+			},
+		},
+		{
+			name: "valid",
+			seed: &ServiceBindingGrant{
+				Spec: ServiceBindingGrantSpec{
+					From: []ServiceBindingGrantFrom{
+						{
+							Group:     "servicebinding.io",
+							Kind:      "ServiceBinding",
+							Namespace: "my-app",
+						},
+					},
+					To: []ServiceBindingGrantTo{
+						{
+							Group: "",
+							Kind:  "Secret",
+							Name:  "my-database",
+						},
+						{
+							Group: "example.com",
+							Kind:  "Database",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid from",
+			seed: &ServiceBindingGrant{
+				Spec: ServiceBindingGrantSpec{
+					From: []ServiceBindingGrantFrom{
+						{
+							Group: "servicebinding.io",
+						},
+						{
+							Group:     "servicebinding.io",
+							Kind:      "ServiceBinding",
+							Namespace: "My_App",
+						},
+					},
+					To: []ServiceBindingGrantTo{
+						{
+							Kind: "Secret",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "from").Index(0).Child("kind"), ""),
+				field.Required(field.NewPath("spec", "from").Index(0).Child("namespace"), ""),
+				field.Invalid(field.NewPath("spec", "from").Index(1).Child("namespace"), "My_App", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		{
+			name: "invalid to",
+			seed: &ServiceBindingGrant{
+				Spec: ServiceBindingGrantSpec{
+					From: []ServiceBindingGrantFrom{
+						{
+							Group:     "servicebinding.io",
+							Kind:      "ServiceBinding",
+							Namespace: "my-app",
+						},
+					},
+					To: []ServiceBindingGrantTo{
+						{
+							Group: "example.com",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "to").Index(0).Child("kind"), ""),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingGrantPermits(t *testing.T) {
+	grant := &ServiceBindingGrant{
+		Spec: ServiceBindingGrantSpec{
+			From: []ServiceBindingGrantFrom{
+				{
+					Group:     "servicebinding.io",
+					Kind:      "ServiceBinding",
+					Namespace: "my-app",
+				},
+			},
+			To: []ServiceBindingGrantTo{
+				{
+					Group: "",
+					Kind:  "Secret",
+					Name:  "my-database",
+				},
+				{
+					Group: "example.com",
+					Kind:  "Database",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		fromNamespace string
+		fromKind      string
+		toGroup       string
+		toKind        string
+		toName        string
+		expected      bool
+	}{
+		{
+			name:          "named service",
+			fromNamespace: "my-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "",
+			toKind:        "Secret",
+			toName:        "my-database",
+			expected:      true,
+		},
+		{
+			name:          "other name",
+			fromNamespace: "my-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "",
+			toKind:        "Secret",
+			toName:        "my-cache",
+			expected:      false,
+		},
+		{
+			name:          "unknown name",
+			fromNamespace: "my-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "",
+			toKind:        "Secret",
+			toName:        "",
+			expected:      true,
+		},
+		{
+			name:          "any name",
+			fromNamespace: "my-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "example.com",
+			toKind:        "Database",
+			toName:        "my-cache",
+			expected:      true,
+		},
+		{
+			name:          "other namespace",
+			fromNamespace: "other-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "example.com",
+			toKind:        "Database",
+			toName:        "my-database",
+			expected:      false,
+		},
+		{
+			name:          "other binding kind",
+			fromNamespace: "my-app",
+			fromKind:      "OtherBinding",
+			toGroup:       "example.com",
+			toKind:        "Database",
+			toName:        "my-database",
+			expected:      false,
+		},
+		{
+			name:          "other service kind",
+			fromNamespace: "my-app",
+			fromKind:      "ServiceBinding",
+			toGroup:       "example.com",
+			toKind:        "Cache",
+			toName:        "my-database",
+			expected:      false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := grant.Permits("servicebinding.io", c.fromKind, c.fromNamespace, c.toGroup, c.toKind, c.toName)
+			if c.expected != actual {
+				t.Errorf("Permits() expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
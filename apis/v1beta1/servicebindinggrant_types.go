@@ -0,0 +1,97 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ServiceBindingGrantFrom describes the bindings that may reference services in the grant's namespace
+type ServiceBindingGrantFrom struct {
+	// Group is the API group of the referencing binding, like `servicebinding.io`
+	Group string `json:"group"`
+	// Kind is the kind of the referencing binding, like `ServiceBinding`
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the referencing binding
+	Namespace string `json:"namespace"`
+}
+
+// ServiceBindingGrantTo describes the services in the grant's namespace that may be referenced
+type ServiceBindingGrantTo struct {
+	// Group is the API group of the referenced service. The empty string is the core API group.
+	Group string `json:"group"`
+	// Kind is the kind of the referenced service, like `Secret`
+	Kind string `json:"kind"`
+	// Name is the name of the referenced service. When empty, every service of the group and kind may be referenced.
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceBindingGrantSpec defines the desired state of ServiceBindingGrant
+type ServiceBindingGrantSpec struct {
+	// From is the collection of bindings, in other namespaces, permitted to reference services in the grant's namespace
+	From []ServiceBindingGrantFrom `json:"from"`
+	// To is the collection of services, in the grant's namespace, that may be referenced
+	To []ServiceBindingGrantTo `json:"to"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ServiceBindingGrant is the Schema for the servicebindinggrants API. A grant permits bindings in other namespaces to
+// reference services in the grant's namespace. Without a grant, a binding may only reference services in its own
+// namespace.
+type ServiceBindingGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceBindingGrantSpec `json:"spec,omitempty"`
+}
+
+// Permits returns true when the grant allows a binding of the group and kind in the namespace to reference the service. An
+// empty service name is permitted when any service of the group and kind may be referenced, the name must be checked again
+// once it is known.
+func (r *ServiceBindingGrant) Permits(fromGroup, fromKind, fromNamespace, toGroup, toKind, toName string) bool {
+	from := false
+	for _, f := range r.Spec.From {
+		if f.Group == fromGroup && f.Kind == fromKind && f.Namespace == fromNamespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+	for _, t := range r.Spec.To {
+		if t.Group == toGroup && t.Kind == toKind && (t.Name == "" || toName == "" || t.Name == toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBindingGrantList contains a list of ServiceBindingGrant
+type ServiceBindingGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceBindingGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceBindingGrant{}, &ServiceBindingGrantList{})
+}
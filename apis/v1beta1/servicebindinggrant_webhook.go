@@ -0,0 +1,104 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ServiceBindingGrant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-servicebindinggrant,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=servicebindinggrants,verbs=create;update,versions=v1beta1,name=vservicebindinggrant.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ServiceBindingGrant{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingGrant) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingGrant) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingGrant) ValidateDelete() error {
+	return nil
+}
+
+func (r *ServiceBindingGrant) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+
+	return errs
+}
+
+func (r *ServiceBindingGrantSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(r.From) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("from"), ""))
+	}
+	for i := range r.From {
+		errs = append(errs, r.From[i].validate(fldPath.Child("from").Index(i))...)
+	}
+	if len(r.To) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("to"), ""))
+	}
+	for i := range r.To {
+		errs = append(errs, r.To[i].validate(fldPath.Child("to").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingGrantFrom) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+	if r.Namespace == "" {
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(r.Namespace) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, msg))
+		}
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingGrantTo) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+
+	return errs
+}
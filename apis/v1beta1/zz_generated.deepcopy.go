@@ -26,6 +26,343 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBindingTypeProfile) DeepCopyInto(out *ClusterBindingTypeProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBindingTypeProfile.
+func (in *ClusterBindingTypeProfile) DeepCopy() *ClusterBindingTypeProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBindingTypeProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBindingTypeProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBindingTypeProfileList) DeepCopyInto(out *ClusterBindingTypeProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBindingTypeProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBindingTypeProfileList.
+func (in *ClusterBindingTypeProfileList) DeepCopy() *ClusterBindingTypeProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBindingTypeProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBindingTypeProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBindingTypeProfileSpec) DeepCopyInto(out *ClusterBindingTypeProfileSpec) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredKeys != nil {
+		in, out := &in.RequiredKeys, &out.RequiredKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBindingTypeProfileSpec.
+func (in *ClusterBindingTypeProfileSpec) DeepCopy() *ClusterBindingTypeProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBindingTypeProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceBinding) DeepCopyInto(out *ClusterServiceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceBinding.
+func (in *ClusterServiceBinding) DeepCopy() *ClusterServiceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServiceBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceBindingList) DeepCopyInto(out *ClusterServiceBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterServiceBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceBindingList.
+func (in *ClusterServiceBindingList) DeepCopy() *ClusterServiceBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServiceBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceBindingSpec) DeepCopyInto(out *ClusterServiceBindingSpec) {
+	*out = *in
+	in.ServiceBindingSpec.DeepCopyInto(&out.ServiceBindingSpec)
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceBindingSpec.
+func (in *ClusterServiceBindingSpec) DeepCopy() *ClusterServiceBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceBindingStatus) DeepCopyInto(out *ClusterServiceBindingStatus) {
+	*out = *in
+	in.ServiceBindingStatus.DeepCopyInto(&out.ServiceBindingStatus)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceBindingStatus.
+func (in *ClusterServiceBindingStatus) DeepCopy() *ClusterServiceBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMapping) DeepCopyInto(out *ClusterServiceResourceMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMapping.
+func (in *ClusterServiceResourceMapping) DeepCopy() *ClusterServiceResourceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServiceResourceMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingEntry) DeepCopyInto(out *ClusterServiceResourceMappingEntry) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(ClusterServiceResourceMappingSecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingEntry.
+func (in *ClusterServiceResourceMappingEntry) DeepCopy() *ClusterServiceResourceMappingEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingList) DeepCopyInto(out *ClusterServiceResourceMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterServiceResourceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingList.
+func (in *ClusterServiceResourceMappingList) DeepCopy() *ClusterServiceResourceMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServiceResourceMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingSecret) DeepCopyInto(out *ClusterServiceResourceMappingSecret) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]ClusterServiceResourceMappingEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingSecret.
+func (in *ClusterServiceResourceMappingSecret) DeepCopy() *ClusterServiceResourceMappingSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingSecretKeyRef) DeepCopyInto(out *ClusterServiceResourceMappingSecretKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingSecretKeyRef.
+func (in *ClusterServiceResourceMappingSecretKeyRef) DeepCopy() *ClusterServiceResourceMappingSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingSpec) DeepCopyInto(out *ClusterServiceResourceMappingSpec) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ClusterServiceResourceMappingTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingSpec.
+func (in *ClusterServiceResourceMappingSpec) DeepCopy() *ClusterServiceResourceMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServiceResourceMappingTemplate) DeepCopyInto(out *ClusterServiceResourceMappingTemplate) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(ClusterServiceResourceMappingSecret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServiceResourceMappingTemplate.
+func (in *ClusterServiceResourceMappingTemplate) DeepCopy() *ClusterServiceResourceMappingTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServiceResourceMappingTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkloadResourceMapping) DeepCopyInto(out *ClusterWorkloadResourceMapping) {
 	*out = *in
@@ -99,6 +436,26 @@ func (in *ClusterWorkloadResourceMappingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadResourceMappingPodTemplate) DeepCopyInto(out *ClusterWorkloadResourceMappingPodTemplate) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ClusterWorkloadResourceMappingContainer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadResourceMappingPodTemplate.
+func (in *ClusterWorkloadResourceMappingPodTemplate) DeepCopy() *ClusterWorkloadResourceMappingPodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadResourceMappingPodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkloadResourceMappingSpec) DeepCopyInto(out *ClusterWorkloadResourceMappingSpec) {
 	*out = *in
@@ -129,6 +486,13 @@ func (in *ClusterWorkloadResourceMappingTemplate) DeepCopyInto(out *ClusterWorkl
 		*out = make([]ClusterWorkloadResourceMappingContainer, len(*in))
 		copy(*out, *in)
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]ClusterWorkloadResourceMappingPodTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadResourceMappingTemplate.
@@ -141,6 +505,21 @@ func (in *ClusterWorkloadResourceMappingTemplate) DeepCopy() *ClusterWorkloadRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvFromMapping) DeepCopyInto(out *EnvFromMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromMapping.
+func (in *EnvFromMapping) DeepCopy() *EnvFromMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvFromMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvMapping) DeepCopyInto(out *EnvMapping) {
 	*out = *in
@@ -156,6 +535,21 @@ func (in *EnvMapping) DeepCopy() *EnvMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileMapping) DeepCopyInto(out *FileMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileMapping.
+func (in *FileMapping) DeepCopy() *FileMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(FileMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
 	*out = *in
@@ -183,6 +577,119 @@ func (in *ServiceBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingGrant) DeepCopyInto(out *ServiceBindingGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingGrant.
+func (in *ServiceBindingGrant) DeepCopy() *ServiceBindingGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBindingGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingGrantFrom) DeepCopyInto(out *ServiceBindingGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingGrantFrom.
+func (in *ServiceBindingGrantFrom) DeepCopy() *ServiceBindingGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingGrantList) DeepCopyInto(out *ServiceBindingGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceBindingGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingGrantList.
+func (in *ServiceBindingGrantList) DeepCopy() *ServiceBindingGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBindingGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingGrantSpec) DeepCopyInto(out *ServiceBindingGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ServiceBindingGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ServiceBindingGrantTo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingGrantSpec.
+func (in *ServiceBindingGrantSpec) DeepCopy() *ServiceBindingGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingGrantTo) DeepCopyInto(out *ServiceBindingGrantTo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingGrantTo.
+func (in *ServiceBindingGrantTo) DeepCopy() *ServiceBindingGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingList) DeepCopyInto(out *ServiceBindingList) {
 	*out = *in
@@ -218,6 +725,16 @@ func (in *ServiceBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingSecretReference) DeepCopyInto(out *ServiceBindingSecretReference) {
 	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvMapping, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingSecretReference.
@@ -230,9 +747,35 @@ func (in *ServiceBindingSecretReference) DeepCopy() *ServiceBindingSecretReferen
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingServiceEntry) DeepCopyInto(out *ServiceBindingServiceEntry) {
+	*out = *in
+	in.Service.DeepCopyInto(&out.Service)
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvMapping, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingServiceEntry.
+func (in *ServiceBindingServiceEntry) DeepCopy() *ServiceBindingServiceEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingServiceEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingServiceReference) DeepCopyInto(out *ServiceBindingServiceReference) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingServiceReference.
@@ -245,16 +788,65 @@ func (in *ServiceBindingServiceReference) DeepCopy() *ServiceBindingServiceRefer
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingServiceStatus) DeepCopyInto(out *ServiceBindingServiceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(ServiceBindingSecretReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingServiceStatus.
+func (in *ServiceBindingServiceStatus) DeepCopy() *ServiceBindingServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 	*out = *in
 	in.Workload.DeepCopyInto(&out.Workload)
-	out.Service = in.Service
+	in.Service.DeepCopyInto(&out.Service)
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceBindingServiceEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvMapping, len(*in))
 		copy(*out, *in)
 	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = new(EnvFromMapping)
+		**out = **in
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(VolumeOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingSpec.
@@ -280,7 +872,19 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 	if in.Binding != nil {
 		in, out := &in.Binding, &out.Binding
 		*out = new(ServiceBindingSecretReference)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceBindingServiceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]ServiceBindingWorkloadStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
@@ -318,3 +922,43 @@ func (in *ServiceBindingWorkloadReference) DeepCopy() *ServiceBindingWorkloadRef
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingWorkloadStatus) DeepCopyInto(out *ServiceBindingWorkloadStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingWorkloadStatus.
+func (in *ServiceBindingWorkloadStatus) DeepCopy() *ServiceBindingWorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingWorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeOptions) DeepCopyInto(out *VolumeOptions) {
+	*out = *in
+	if in.DefaultMode != nil {
+		in, out := &in.DefaultMode, &out.DefaultMode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadOnly != nil {
+		in, out := &in.ReadOnly, &out.ReadOnly
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeOptions.
+func (in *VolumeOptions) DeepCopy() *VolumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+	"github.com/servicebinding/runtime/projector"
+)
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// ClusterServiceBindingReconciler reconciles a ClusterServiceBinding object. The cluster binding is reconciled as a
+// ServiceBinding in each selected namespace.
+func ClusterServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler {
+	return &reconcilers.ResourceReconciler{
+		Type: &servicebindingv1beta1.ClusterServiceBinding{},
+		Reconciler: &reconcilers.WithFinalizer{
+			Finalizer: servicebindingv1beta1.GroupVersion.Group + "/finalizer",
+			Reconciler: reconcilers.Sequence{
+				ResolveNamespaces(),
+				ForEachNamespace(reconcilers.Sequence{
+					ForEachService(reconcilers.Sequence{
+						ResolveBindingSecret(),
+						ReconcileSynthesizedSecret(),
+						ReconcileEnvSecret(),
+					}),
+					ResolveWorkloads(),
+					ProjectBinding(),
+					PatchWorkloads(),
+				}),
+			},
+		},
+
+		Config: c,
+	}
+}
+
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+func ResolveNamespaces() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name:                   "ResolveNamespaces",
+		SyncDuringFinalization: true,
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			selector, err := metav1.LabelSelectorAsSelector(&resource.Spec.NamespaceSelector)
+			if err != nil {
+				return err
+			}
+			namespaces := &corev1.NamespaceList{}
+			if err := c.List(ctx, namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return err
+			}
+			names := []string{}
+			for _, namespace := range namespaces.Items {
+				if !namespace.DeletionTimestamp.IsZero() {
+					// nothing new may be created in a terminating namespace
+					continue
+				}
+				names = append(names, namespace.Name)
+			}
+
+			StashNamespaces(ctx, names)
+
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &corev1.Namespace{}}, handler.EnqueueRequestsFromMapFunc(selectingClusterServiceBindings(ctx, mgr.GetClient())))
+			return nil
+		},
+	}
+}
+
+// selectingClusterServiceBindings maps a Namespace to the cluster service bindings that select the namespace, or are
+// projected into the namespace
+func selectingClusterServiceBindings(ctx context.Context, c client.Client) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		log := logr.FromContextOrDiscard(ctx)
+		namespace := obj.(*corev1.Namespace)
+
+		clusterServiceBindings := &servicebindingv1beta1.ClusterServiceBindingList{}
+		if err := c.List(ctx, clusterServiceBindings); err != nil {
+			log.Error(err, "unable to list cluster service bindings for namespace", "namespace", namespace.Name)
+			return nil
+		}
+		requests := []reconcile.Request{}
+		for i := range clusterServiceBindings.Items {
+			clusterServiceBinding := &clusterServiceBindings.Items[i]
+			if clusterServiceBinding.SelectsNamespace(&namespace.ObjectMeta) || sets.NewString(clusterServiceBinding.Status.Namespaces...).Has(namespace.Name) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(clusterServiceBinding)})
+			}
+		}
+		return requests
+	}
+}
+
+// ForEachNamespace reconciles the cluster service binding as a ServiceBinding in each namespace, each with a stash of
+// its own. The binding is unprojected from namespaces that are no longer selected, and the secrets owned by the binding
+// in those namespaces are deleted. The conditions of the cluster binding reflect the first namespace that is not ready.
+func ForEachNamespace(reconciler reconcilers.SubReconciler) reconcilers.SubReconciler {
+	secretManager := ownedSecretManager("ForEachNamespace")
+
+	return &reconcilers.SyncReconciler{
+		Name:                   "ForEachNamespace",
+		SyncDuringFinalization: true,
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterServiceBinding) (reconcile.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			selected := sets.NewString()
+			if resource.DeletionTimestamp.IsZero() {
+				selected.Insert(RetrieveNamespaces(ctx)...)
+			}
+
+			// events for the service binding in each namespace are recorded for the cluster binding
+			pc := reconcilers.RetrieveOriginalConfigOrDie(ctx)
+			pc.Recorder = &clusterServiceBindingRecorder{EventRecorder: pc.Recorder, clusterServiceBinding: resource}
+			ctx = reconcilers.StashOriginalConfig(ctx, pc)
+
+			// namespaces are recorded before they are projected, and forgotten once they are unprojected
+			namespaces := selected.Union(sets.NewString(resource.Status.Namespaces...)).List()
+			resource.Status.Namespaces = namespaces
+
+			result := reconcile.Result{}
+			serviceBindings := []*servicebindingv1beta1.ServiceBinding{}
+			var workloads []servicebindingv1beta1.ServiceBindingWorkloadStatus
+			for _, namespace := range namespaces {
+				serviceBinding := resource.ServiceBinding(namespace)
+				if !selected.Has(namespace) && serviceBinding.DeletionTimestamp.IsZero() {
+					// unproject the binding from a namespace that is no longer selected as if the binding was deleted
+					now := metav1.Now()
+					serviceBinding.DeletionTimestamp = &now
+				}
+				namespaceResult, err := reconciler.Reconcile(reconcilers.WithStash(ctx), serviceBinding)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				result = reconcilers.AggregateResults(result, namespaceResult)
+				for _, workload := range serviceBinding.Status.Workloads {
+					workload.Namespace = namespace
+					workloads = append(workloads, workload)
+				}
+
+				if !selected.Has(namespace) {
+					// the secrets owned by the binding are read by name as unstructured objects, which are not cached,
+					// rather than listing every secret in the namespace
+					for _, unit := range projector.ServiceUnits(serviceBinding) {
+						for _, name := range []string{synthesizedSecretName(unit), projector.EnvSecretName(unit)} {
+							obj := &unstructured.Unstructured{}
+							obj.SetAPIVersion("v1")
+							obj.SetKind("Secret")
+							if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+								if apierrs.IsNotFound(err) {
+									continue
+								}
+								return reconcile.Result{}, err
+							}
+							secret := &corev1.Secret{}
+							if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), secret); err != nil {
+								return reconcile.Result{}, err
+							}
+							if !metav1.IsControlledBy(secret, resource) {
+								continue
+							}
+							if _, err := secretManager.Manage(ctx, resource, secret, nil); err != nil {
+								return reconcile.Result{}, err
+							}
+						}
+					}
+					continue
+				}
+				serviceBindings = append(serviceBindings, serviceBinding)
+			}
+			resource.Status.Workloads = workloads
+			resource.Status.WorkloadCount = int32(len(workloads))
+			resource.Status.Namespaces = nil
+			if selected.Len() != 0 {
+				resource.Status.Namespaces = selected.List()
+			}
+
+			if len(serviceBindings) == 0 {
+				if resource.DeletionTimestamp.IsZero() {
+					// leave Unknown, a namespace may be created or labeled shortly
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "NamespacesNotFound", "no namespaces are selected by the namespace selector")
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "NamespacesNotFound", "no namespaces are selected by the namespace selector")
+				}
+				return result, nil
+			}
+
+			// the service is resolved in the same way for each namespace
+			resource.Status.Binding = serviceBindings[0].Status.Binding
+			resource.Status.Services = serviceBindings[0].Status.Services
+			for _, conditionType := range []string{servicebindingv1beta1.ServiceBindingConditionServiceAvailable, servicebindingv1beta1.ServiceBindingConditionWorkloadProjected} {
+				aggregateNamespaceCondition(resource, serviceBindings, conditionType)
+			}
+
+			return result, nil
+		},
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			// the reconciler operates on the service binding for each namespace
+			ctx = reconcilers.StashResourceType(ctx, &servicebindingv1beta1.ServiceBinding{})
+			return reconciler.SetupWithManager(ctx, mgr, bldr)
+		},
+	}
+}
+
+// aggregateNamespaceCondition sets the condition of the cluster service binding from the first service binding whose
+// condition is False, then Unknown. The condition is True when the condition of every service binding is True.
+func aggregateNamespaceCondition(resource *servicebindingv1beta1.ClusterServiceBinding, serviceBindings []*servicebindingv1beta1.ServiceBinding, conditionType string) {
+	for _, status := range []metav1.ConditionStatus{metav1.ConditionFalse, metav1.ConditionUnknown} {
+		for _, serviceBinding := range serviceBindings {
+			if cond := serviceBinding.Status.GetCondition(conditionType); cond != nil && cond.Status == status {
+				// report the first namespace that is not ready
+				message := fmt.Sprintf("namespace %q: %s", serviceBinding.Namespace, cond.Message)
+				if status == metav1.ConditionFalse {
+					resource.GetConditionManager().MarkFalse(conditionType, cond.Reason, "%s", message)
+				} else {
+					resource.GetConditionManager().MarkUnknown(conditionType, cond.Reason, "%s", message)
+				}
+				return
+			}
+		}
+	}
+	reason := ""
+	if cond := serviceBindings[0].Status.GetCondition(conditionType); cond != nil {
+		reason = cond.Reason
+	}
+	resource.GetConditionManager().MarkTrue(conditionType, reason, "")
+}
+
+// clusterServiceBindingRef returns the reference to the ClusterServiceBinding the service binding is projected for, or
+// nil for a service binding that is not projected for a cluster binding
+func clusterServiceBindingRef(resource *servicebindingv1beta1.ServiceBinding) *metav1.OwnerReference {
+	if ref := metav1.GetControllerOf(resource); ref != nil && ref.Kind == "ClusterServiceBinding" && ref.UID == resource.UID {
+		return ref
+	}
+	return nil
+}
+
+// clusterServiceBindingRecorder records events for the cluster service binding, rather than for the object the event
+// is recorded for
+type clusterServiceBindingRecorder struct {
+	record.EventRecorder
+	clusterServiceBinding *servicebindingv1beta1.ClusterServiceBinding
+}
+
+func (r *clusterServiceBindingRecorder) Event(_ runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(r.clusterServiceBinding, eventtype, reason, message)
+}
+
+func (r *clusterServiceBindingRecorder) Eventf(_ runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(r.clusterServiceBinding, eventtype, reason, messageFmt, args...)
+}
+
+func (r *clusterServiceBindingRecorder) AnnotatedEventf(_ runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(r.clusterServiceBinding, annotations, eventtype, reason, messageFmt, args...)
+}
+
+const NamespacesStashKey reconcilers.StashKey = "servicebinding.io:namespaces"
+
+func StashNamespaces(ctx context.Context, namespaces []string) {
+	reconcilers.StashValue(ctx, NamespacesStashKey, namespaces)
+}
+
+func RetrieveNamespaces(ctx context.Context) []string {
+	value := reconcilers.RetrieveValue(ctx, NamespacesStashKey)
+	if namespaces, ok := value.([]string); ok {
+		return namespaces
+	}
+	return nil
+}
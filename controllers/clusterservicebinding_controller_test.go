@@ -0,0 +1,564 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dieappsv1 "dies.dev/apis/apps/v1"
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+	"github.com/servicebinding/runtime/controllers"
+	dieservicebindingv1beta1 "github.com/servicebinding/runtime/dies/v1beta1"
+)
+
+func TestClusterServiceBindingReconciler(t *testing.T) {
+	namespace := "tenant-a"
+	serviceNamespace := "platform"
+	name := "my-binding"
+	uid := types.UID("dde10100-d7b3-4cba-9430-51d60a8612a6")
+	secretName := "my-secret"
+	replicatedSecretName := fmt.Sprintf("servicebinding-secret-%s", uid)
+	key := types.NamespacedName{Name: name}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	now := metav1.Now().Rfc3339Copy()
+
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+			d.UID(uid)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("v1")
+					d.Kind("Secret")
+					d.Name(secretName)
+					d.Namespace(serviceNamespace)
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("apps/v1")
+					d.Kind("Deployment")
+					d.Name("my-workload")
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+				d.AddMatchLabel("tenant", "true")
+			})
+		})
+
+	selectedNamespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(namespace)
+			d.AddLabel("tenant", "true")
+		})
+	otherNamespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other")
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		DieStamp(func(r *appsv1.Deployment) {
+			r.APIVersion = "apps/v1"
+			r.Kind = "Deployment"
+		}).
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload")
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.Image("scratch")
+					})
+				})
+			})
+		})
+	projectedWorkload := workload.
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/secret-%s", uid), replicatedSecretName)
+				})
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.EnvDie("SERVICE_BINDING_ROOT", func(d *diecorev1.EnvVarDie) {
+							d.Value("/bindings")
+						})
+						d.VolumeMountDie(fmt.Sprintf("servicebinding-%s", uid), func(d *diecorev1.VolumeMountDie) {
+							d.MountPath(fmt.Sprintf("/bindings/%s", name))
+							d.ReadOnly(true)
+						})
+					})
+					d.VolumeDie(fmt.Sprintf("servicebinding-%s", uid), func(d *diecorev1.VolumeDie) {
+						d.ProjectedDie(func(d *diecorev1.ProjectedVolumeSourceDie) {
+							d.SourcesDie(
+								diecorev1.VolumeProjectionBlank.
+									SecretDie(func(d *diecorev1.SecretProjectionDie) {
+										d.Name(replicatedSecretName)
+									}),
+							)
+						})
+					})
+				})
+			})
+		})
+	// TODO find a better way to avoid empty vs nil objects that are lost in the unstructured conversion
+	unprojectedWorkload := workload.
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.EnvDie("SERVICE_BINDING_ROOT", func(d *diecorev1.EnvVarDie) {
+							d.Value("/bindings")
+						})
+					})
+				})
+			})
+		}).DieReleaseUnstructured()
+	unstructured.SetNestedMap(unprojectedWorkload.UnstructuredContent(), map[string]interface{}{}, "spec", "template", "metadata", "annotations")
+	containers, _, _ := unstructured.NestedSlice(unprojectedWorkload.UnstructuredContent(), "spec", "template", "spec", "containers")
+	unstructured.SetNestedSlice(containers[0].(map[string]interface{}), []interface{}{}, "volumeMounts")
+	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), containers, "spec", "template", "spec", "containers")
+	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), []interface{}{}, "spec", "template", "spec", "volumes")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: serviceNamespace,
+			Name:      secretName,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+	replicatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      replicatedSecretName,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1beta1",
+					Kind:               "ClusterServiceBinding",
+					Name:               name,
+					UID:                uid,
+					Controller:         pointer.Bool(true),
+					BlockOwnerDeletion: pointer.Bool(true),
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: secret.Data,
+	}
+	existingReplicatedSecret := replicatedSecret.DeepCopy()
+	existingReplicatedSecret.CreationTimestamp = now
+	existingReplicatedSecret.ResourceVersion = "999"
+	envSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("servicebinding-env-%s", uid),
+		},
+	}
+
+	rts := rtesting.ReconcilerTestSuite{{
+		Name: "newly created",
+		Key:  key,
+		GivenObjects: []client.Object{
+			clusterServiceBinding,
+			selectedNamespace,
+			otherNamespace,
+			workload,
+			secret,
+		},
+		ExpectTracks: []rtesting.TrackRequest{
+			rtesting.NewTrackRequest(secret, clusterServiceBinding, scheme),
+			rtesting.NewTrackRequest(replicatedSecret, clusterServiceBinding, scheme),
+			rtesting.NewTrackRequest(envSecret, clusterServiceBinding, scheme),
+			rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+		},
+		ExpectEvents: []rtesting.Event{
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "FinalizerPatched", "Patched finalizer %q", "servicebinding.io/finalizer"),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Created", "Created Secret %q", replicatedSecretName),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+		},
+		ExpectPatches: []rtesting.PatchRef{
+			{
+				Group:     "servicebinding.io",
+				Kind:      "ClusterServiceBinding",
+				Name:      clusterServiceBinding.GetName(),
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":["servicebinding.io/finalizer"],"resourceVersion":"999"}}`),
+			},
+		},
+		ExpectCreates: []client.Object{
+			replicatedSecret,
+		},
+		ExpectUpdates: []client.Object{
+			projectedWorkload.DieReleaseUnstructured().(client.Object),
+		},
+		ExpectStatusUpdates: []client.Object{
+			clusterServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+					d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						)
+						d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+							d.Name(replicatedSecretName)
+						})
+						d.Workloads(servicebindingv1beta1.ServiceBindingWorkloadStatus{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  namespace,
+							Name:       "my-workload",
+							Result:     servicebindingv1beta1.ServiceBindingWorkloadResultProjected,
+							Reason:     "WorkloadProjected",
+						})
+						d.WorkloadCount(1)
+					})
+					d.Namespaces(namespace)
+				}),
+		},
+	}, {
+		Name: "namespace no longer selected",
+		Key:  key,
+		GivenObjects: []client.Object{
+			clusterServiceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+					d.Namespaces(namespace)
+				}),
+			selectedNamespace.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Labels(nil)
+				}),
+			projectedWorkload,
+			existingReplicatedSecret,
+		},
+		ExpectTracks: []rtesting.TrackRequest{
+			rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+		},
+		ExpectEvents: []rtesting.Event{
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", replicatedSecretName),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+		},
+		ExpectUpdates: []client.Object{
+			unprojectedWorkload.(client.Object),
+		},
+		ExpectDeletes: []rtesting.DeleteRef{
+			rtesting.NewDeleteRefFromObject(existingReplicatedSecret, scheme),
+		},
+		ExpectStatusUpdates: []client.Object{
+			clusterServiceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+					d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ServiceBindingConditionReady.
+								Reason("NamespacesNotFound").
+								Message("no namespaces are selected by the namespace selector"),
+							dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+								Reason("NamespacesNotFound").
+								Message("no namespaces are selected by the namespace selector"),
+							dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+								Reason("NamespacesNotFound").
+								Message("no namespaces are selected by the namespace selector"),
+						)
+					})
+				}),
+		},
+	}, {
+		Name: "terminating",
+		Key:  key,
+		GivenObjects: []client.Object{
+			clusterServiceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+					d.Namespaces(namespace)
+				}),
+			selectedNamespace,
+			projectedWorkload,
+			existingReplicatedSecret,
+		},
+		ExpectTracks: []rtesting.TrackRequest{
+			rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+		},
+		ExpectEvents: []rtesting.Event{
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", replicatedSecretName),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "FinalizerPatched", "Patched finalizer %q", "servicebinding.io/finalizer"),
+			rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+		},
+		ExpectPatches: []rtesting.PatchRef{
+			{
+				Group:     "servicebinding.io",
+				Kind:      "ClusterServiceBinding",
+				Name:      clusterServiceBinding.GetName(),
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+			},
+		},
+		ExpectUpdates: []client.Object{
+			unprojectedWorkload.(client.Object),
+		},
+		ExpectDeletes: []rtesting.DeleteRef{
+			rtesting.NewDeleteRefFromObject(existingReplicatedSecret, scheme),
+		},
+		ExpectStatusUpdates: []client.Object{
+			clusterServiceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+				}),
+		},
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		return controllers.ClusterServiceBindingReconciler(c)
+	})
+}
+
+func TestResolveNamespaces(t *testing.T) {
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	now := metav1.Now().Rfc3339Copy()
+
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+				d.AddMatchLabel("tenant", "true")
+			})
+		})
+
+	tenantA := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("tenant-a")
+			d.AddLabel("tenant", "true")
+		})
+	tenantB := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("tenant-b")
+			d.AddLabel("tenant", "true")
+		})
+	other := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other")
+		})
+
+	rts := rtesting.SubReconcilerTestSuite{{
+		Name:           "no namespaces",
+		Resource:       clusterServiceBinding,
+		ExpectResource: clusterServiceBinding,
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{},
+		},
+	}, {
+		Name:     "selected namespaces",
+		Resource: clusterServiceBinding,
+		GivenObjects: []client.Object{
+			tenantA,
+			tenantB,
+			other,
+		},
+		ExpectResource: clusterServiceBinding,
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{"tenant-a", "tenant-b"},
+		},
+	}, {
+		Name: "every namespace",
+		Resource: clusterServiceBinding.
+			SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+				d.NamespaceSelector(metav1.LabelSelector{})
+			}),
+		GivenObjects: []client.Object{
+			tenantA,
+			other,
+		},
+		ExpectResource: clusterServiceBinding.
+			SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+				d.NamespaceSelector(metav1.LabelSelector{})
+			}),
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{"other", "tenant-a"},
+		},
+	}, {
+		Name:     "ignore terminating namespaces",
+		Resource: clusterServiceBinding,
+		GivenObjects: []client.Object{
+			tenantA,
+			tenantB.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("kubernetes")
+				}),
+		},
+		ExpectResource: clusterServiceBinding,
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{"tenant-a"},
+		},
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolveNamespaces()
+	})
+}
+
+func TestForEachNamespace(t *testing.T) {
+	name := "my-binding"
+	uid := types.UID("dde10100-d7b3-4cba-9430-51d60a8612a6")
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+			d.UID(uid)
+		}).
+		StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+			d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+				d.ConditionsDie(
+					dieservicebindingv1beta1.ServiceBindingConditionReady.Reason("Initializing"),
+					dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.Reason("Initializing"),
+					dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.Reason("Initializing"),
+				)
+			})
+		})
+
+	// marks the service binding of each namespace as projected, except for namespaces with a collision
+	projectNamespace := &reconcilers.SyncReconciler{
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ResolvedBindingSecret", "")
+			if resource.Namespace == "tenant-b" {
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "ProjectionCollision", "collides with the workload")
+				return nil
+			}
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadProjected", "")
+			return nil
+		},
+	}
+
+	rts := rtesting.SubReconcilerTestSuite{{
+		Name:     "project each namespace",
+		Resource: clusterServiceBinding,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{"tenant-a", "tenant-c"},
+		},
+		ExpectResource: clusterServiceBinding.
+			StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+				d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+					)
+				})
+				d.Namespaces("tenant-a", "tenant-c")
+			}),
+	}, {
+		Name:     "collision in a namespace",
+		Resource: clusterServiceBinding,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{"tenant-a", "tenant-b"},
+		},
+		ExpectResource: clusterServiceBinding.
+			StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+				d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.False().
+							Reason("ProjectionCollision").
+							Message(`namespace "tenant-b": collides with the workload`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.False().
+							Reason("ProjectionCollision").
+							Message(`namespace "tenant-b": collides with the workload`),
+					)
+				})
+				d.Namespaces("tenant-a", "tenant-b")
+			}),
+	}, {
+		Name:     "no namespaces selected",
+		Resource: clusterServiceBinding,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.NamespacesStashKey: []string{},
+		},
+		ExpectResource: clusterServiceBinding.
+			StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+				d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("NamespacesNotFound").
+							Message("no namespaces are selected by the namespace selector"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("NamespacesNotFound").
+							Message("no namespaces are selected by the namespace selector"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							Reason("NamespacesNotFound").
+							Message("no namespaces are selected by the namespace selector"),
+					)
+				})
+			}),
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ForEachNamespace(projectNamespace)
+	})
+}
@@ -17,16 +17,29 @@ limitations under the License.
 package controllers
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"path"
+	"strings"
+	"text/template"
 
+	"github.com/go-logr/logr"
 	"github.com/vmware-labs/reconciler-runtime/apis"
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctlr "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +56,7 @@ import (
 //+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // ServiceBindingReconciler reconciles a ServiceBinding object
 func ServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler {
@@ -51,7 +65,11 @@ func ServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconci
 		Reconciler: &reconcilers.WithFinalizer{
 			Finalizer: servicebindingv1beta1.GroupVersion.Group + "/finalizer",
 			Reconciler: reconcilers.Sequence{
-				ResolveBindingSecret(),
+				ForEachService(reconcilers.Sequence{
+					ResolveBindingSecret(),
+					ReconcileSynthesizedSecret(),
+					ReconcileEnvSecret(),
+				}),
 				ResolveWorkloads(),
 				ProjectBinding(),
 				PatchWorkloads(),
@@ -62,6 +80,73 @@ func ServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconci
 	}
 }
 
+// ForEachService reconciles each service bound by the service binding. A binding with services is reconciled as a unit
+// for each service, each with a stash of its own. The binding secret and ServiceAvailable condition of each unit are
+// reflected onto the binding's `.status.services`, the binding's ServiceAvailable condition is only True once every
+// service is available.
+func ForEachService(reconciler reconcilers.SubReconciler) reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ForEachService",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) (reconcile.Result, error) {
+			if len(resource.Spec.Services) == 0 {
+				resource.Status.Services = nil
+				return reconciler.Reconcile(ctx, resource)
+			}
+
+			result := reconcile.Result{}
+			services := []servicebindingv1beta1.ServiceBindingServiceStatus{}
+			for _, unit := range projector.ServiceUnits(resource) {
+				previous := unit.Status.GetCondition(servicebindingv1beta1.ServiceBindingConditionServiceAvailable)
+				unit.Status.Conditions = nil
+				unit.Status.InitializeConditions()
+				unitResult, err := reconciler.Reconcile(reconcilers.WithStash(ctx), unit)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				result = reconcilers.AggregateResults(result, unitResult)
+
+				available := unit.Status.GetCondition(servicebindingv1beta1.ServiceBindingConditionServiceAvailable)
+				if previous != nil && previous.Status == available.Status && previous.Reason == available.Reason && previous.Message == available.Message {
+					// restore last transition time for an unchanged condition
+					available.LastTransitionTime = previous.LastTransitionTime
+				}
+				services = append(services, servicebindingv1beta1.ServiceBindingServiceStatus{
+					Name:       unit.Spec.Name,
+					Conditions: []metav1.Condition{*available},
+					Binding:    unit.Status.Binding,
+				})
+			}
+			resource.Status.Binding = nil
+			resource.Status.Services = services
+
+			for _, status := range []metav1.ConditionStatus{metav1.ConditionFalse, metav1.ConditionUnknown} {
+				for _, service := range services {
+					if cond := service.Conditions[0]; cond.Status == status {
+						// report the first service that is not available
+						message := fmt.Sprintf("service %q: %s", service.Name, cond.Message)
+						if status == metav1.ConditionFalse {
+							resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, cond.Reason, "%s", message)
+						} else {
+							resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, cond.Reason, "%s", message)
+						}
+						return result, nil
+					}
+				}
+			}
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ResolvedBindingSecrets", "")
+
+			return result, nil
+		},
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			return reconciler.SetupWithManager(ctx, mgr, bldr)
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterbindingtypeprofiles,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterserviceresourcemappings,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindinggrants,verbs=get;list;watch
+
 func ResolveBindingSecret() reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name: "ResolveBindingSecret",
@@ -74,7 +159,88 @@ func ResolveBindingSecret() reconcilers.SubReconciler {
 				Namespace:  resource.Namespace,
 				Name:       resource.Spec.Service.Name,
 			}
-			secretName, err := resolver.New(c).LookupBindingSecret(ctx, ref)
+			if resource.Spec.Service.Namespace != "" {
+				ref.Namespace = resource.Spec.Service.Namespace
+			}
+			crossNamespace := ref.Namespace != resource.Namespace
+			r := resolver.New(c)
+			// a cluster binding may reference a service in any namespace
+			granted := func() (bool, error) {
+				if !crossNamespace || clusterServiceBindingRef(resource) != nil {
+					return true, nil
+				}
+				bindingRef := corev1.ObjectReference{
+					APIVersion: servicebindingv1beta1.GroupVersion.String(),
+					Kind:       "ServiceBinding",
+					Namespace:  resource.Namespace,
+					Name:       resource.Name,
+				}
+				grant, err := r.LookupServiceGrant(ctx, bindingRef, ref)
+				if err != nil {
+					return false, err
+				}
+				if grant == nil {
+					// set False, the owner of the service's namespace must grant access to the service
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceNotGranted", "the service in namespace %q is not granted to the binding by a ServiceBindingGrant", ref.Namespace)
+					resource.Status.Binding = nil
+					return false, nil
+				}
+				return true, nil
+			}
+			// check the grant before selecting services, the services in a namespace that is not granted must not be listed
+			if ok, err := granted(); err != nil || !ok {
+				return err
+			}
+			if resource.Spec.Service.Selector != nil {
+				name, err := r.LookupServiceName(ctx, ref, resource.Spec.Service.Selector)
+				if err != nil {
+					var ambiguousErr *resolver.AmbiguousServiceError
+					if errors.As(err, &ambiguousErr) {
+						// set False, the selector must be narrowed or the priority of the services changed
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AmbiguousServiceSelector", "%s", err.Error())
+						return nil
+					}
+					if apierrs.IsForbidden(err) {
+						// set False, the operator needs to give access to the resource
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceForbidden", "the controller does not have permission to list the service")
+						return nil
+					}
+					return err
+				}
+				if name == "" {
+					// leave Unknown, a service may be created or labeled shortly
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceNotFound", "no service matches the selector")
+					return nil
+				}
+				ref.Name = name
+				// the grant may be limited to services by name
+				if ok, err := granted(); err != nil || !ok {
+					return err
+				}
+			}
+			mapping, err := r.LookupServiceMapping(ctx, ref)
+			if err != nil {
+				return err
+			}
+			var secretName string
+			var synthesized *corev1.Secret
+			if mapping != nil && mapping.Secret != nil {
+				// the service does not expose a binding secret, it is synthesized from the service
+				var data map[string][]byte
+				if data, err = r.SynthesizeBindingSecret(ctx, ref, mapping.Secret); err == nil {
+					synthesized = &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: resource.Namespace,
+							Name:      synthesizedSecretName(resource),
+						},
+						Data: data,
+					}
+					secretName = synthesized.Name
+					StashSynthesizedSecret(ctx, synthesized)
+				}
+			} else {
+				secretName, err = r.LookupBindingSecret(ctx, ref)
+			}
 			if err != nil {
 				if apierrs.IsNotFound(err) {
 					// leave Unknown, the provisioned service may be created shortly
@@ -91,10 +257,118 @@ func ResolveBindingSecret() reconcilers.SubReconciler {
 				return err
 			}
 
+			if resource.Spec.RequireServiceReady && !(ref.APIVersion == "v1" && ref.Kind == "Secret") {
+				ready, err := r.LookupServiceReadyCondition(ctx, ref)
+				if err != nil {
+					return err
+				}
+				if ready == nil || ready.Status != metav1.ConditionTrue {
+					// hold back the binding until the service is ready, a binding that is already projected is left as is
+					markServiceNotReady(resource, ready)
+					return nil
+				}
+			}
+
 			if secretName != "" {
+				resource.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{
+					Name:     secretName,
+					Type:     resource.Spec.Type,
+					Provider: resource.Spec.Provider,
+				}
+				replicated := crossNamespace && synthesized == nil
+				if replicated {
+					// the binding secret is in the service's namespace, it is replicated into the binding's namespace
+					resource.Status.Binding.Name = synthesizedSecretName(resource)
+				}
+				var profile *servicebindingv1beta1.ClusterBindingTypeProfile
+				if resource.Spec.Type != "" {
+					profile, err = r.LookupBindingTypeProfile(ctx, resource.Spec.Type)
+					if err != nil {
+						return err
+					}
+				}
+				// a directly referenced secret is always read, so that the binding tracks the secret being deleted or created
+				direct := ref.APIVersion == "v1" && ref.Kind == "Secret"
+				if readsBindingSecret(resource) || (profile != nil && len(profile.Spec.RequiredKeys) != 0) || replicated || direct {
+					secretRef := corev1.ObjectReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Namespace:  ref.Namespace,
+						Name:       secretName,
+					}
+					secret := synthesized
+					if secret == nil {
+						secret, err = r.LookupSecret(ctx, secretRef)
+					}
+					if err != nil {
+						if apierrs.IsNotFound(err) && direct {
+							// set False, the directly referenced secret is the service. The binding recovers once the secret is
+							// created
+							resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "SecretNotFound", "the binding secret was not found")
+							return nil
+						}
+						if apierrs.IsNotFound(err) {
+							// leave Unknown, the secret may be created shortly
+							resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "SecretNotFound", "the binding secret was not found")
+							return nil
+						}
+						if apierrs.IsForbidden(err) {
+							// set False, the operator needs to give access to the resource
+							resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "SecretForbidden", "the controller does not have permission to get the binding secret")
+							return nil
+						}
+						return err
+					}
+					if resource.Status.Binding.Type == "" {
+						resource.Status.Binding.Type = secretBindingType(secret)
+						if resource.Status.Binding.Type != "" {
+							profile, err = r.LookupBindingTypeProfile(ctx, resource.Status.Binding.Type)
+							if err != nil {
+								return err
+							}
+						}
+					}
+					if resource.Status.Binding.Provider == "" {
+						resource.Status.Binding.Provider = string(secret.Data["provider"])
+					}
+					if replicated {
+						secret = &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: resource.Namespace,
+								Name:      resource.Status.Binding.Name,
+							},
+							Data: secret.Data,
+						}
+						StashSynthesizedSecret(ctx, secret)
+					}
+					if profile != nil {
+						resource.Status.Binding.Env = profile.Spec.Env
+					}
+					if resource.Spec.EnvFrom != nil || isConsolidated(resource) {
+						// every entry is projected as an environment variable, or as a path within the consolidated
+						// volume, the keys must be known
+						resource.Status.Binding.Keys = sets.StringKeySet(secret.Data).List()
+					}
+					if resource.Spec.RolloutOnRotation {
+						resource.Status.Binding.Hash = secretHash(secret)
+					}
+					if missing := missingKeys(secret, profile); len(missing) != 0 {
+						// set False, the service must provide the entries required for the binding type
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "MissingRequiredKeys", "the binding secret is missing keys required by the %q binding type: %s", resource.Status.Binding.Type, strings.Join(missing, ", "))
+						return nil
+					}
+					StashBindingSecret(ctx, secret)
+				} else if profile != nil {
+					resource.Status.Binding.Env = profile.Spec.Env
+				}
+				if p, entry := conflictingFilePath(resource); entry != "" {
+					// set False, the file mapping must be changed by the user, the type or provider may be read from the
+					// binding secret
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "FilePathConflict", "the file path %q conflicts with the projected %s", p, entry)
+					return nil
+				}
 				// success
 				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ResolvedBindingSecret", "")
-				resource.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: secretName}
 			} else {
 				// leave Unknown, not success but also not an error
 				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceMissingBinding", "the service was found, but did not contain a binding secret")
@@ -104,7 +378,320 @@ func ResolveBindingSecret() reconcilers.SubReconciler {
 
 			return nil
 		},
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterBindingTypeProfile{}}, reconcilers.EnqueueTracked(ctx, &servicebindingv1beta1.ClusterBindingTypeProfile{}))
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterServiceResourceMapping{}}, handler.Funcs{})
+			if _, ok := reconcilers.RetrieveOriginalResourceType(ctx).(*servicebindingv1beta1.ServiceBinding); ok {
+				// grants only apply to namespaced service bindings
+				bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ServiceBindingGrant{}}, handler.EnqueueRequestsFromMapFunc(grantedServiceBindings(ctx, mgr.GetClient())))
+			}
+			return nil
+		},
+	}
+}
+
+// markServiceNotReady mirrors the service's Ready condition onto the ServiceAvailable condition of the binding. A
+// service that does not report a Ready condition is treated as not yet ready.
+func markServiceNotReady(resource *servicebindingv1beta1.ServiceBinding, ready *metav1.Condition) {
+	reason := "ServiceNotReady"
+	message := "the service is not ready"
+	if ready != nil && ready.Reason != "" {
+		reason = ready.Reason
+	}
+	if ready != nil && ready.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, ready.Message)
+	}
+	if ready != nil && ready.Status == metav1.ConditionFalse {
+		// set False, the service reports it is not ready
+		resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, reason, "%s", message)
+		return
+	}
+	// leave Unknown, the service may become ready shortly
+	resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, reason, "%s", message)
+}
+
+// grantedServiceBindings maps a ServiceBindingGrant to the service bindings, in the namespaces the grant is from, that
+// reference a service in the grant's namespace
+func grantedServiceBindings(ctx context.Context, c client.Client) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		log := logr.FromContextOrDiscard(ctx)
+		grant := obj.(*servicebindingv1beta1.ServiceBindingGrant)
+
+		namespaces := sets.NewString()
+		for _, from := range grant.Spec.From {
+			namespaces.Insert(from.Namespace)
+		}
+		requests := []reconcile.Request{}
+		for _, namespace := range namespaces.List() {
+			serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+			if err := c.List(ctx, serviceBindings, client.InNamespace(namespace)); err != nil {
+				log.Error(err, "unable to list service bindings for grant", "grant", client.ObjectKeyFromObject(grant), "namespace", namespace)
+				continue
+			}
+			for i := range serviceBindings.Items {
+				for _, unit := range projector.ServiceUnits(&serviceBindings.Items[i]) {
+					if unit.Spec.Service.Namespace == grant.Namespace {
+						requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&serviceBindings.Items[i])})
+						break
+					}
+				}
+			}
+		}
+		return requests
+	}
+}
+
+// readsBindingSecret returns true when the content of the binding secret, rather than just the name, is required to project
+// the binding. The type and provider of the binding are read from the secret unless defined by the binding.
+func readsBindingSecret(serviceBinding *servicebindingv1beta1.ServiceBinding) bool {
+	return serviceBinding.Spec.Type == "" || serviceBinding.Spec.Provider == "" || serviceBinding.Spec.EnvFrom != nil || serviceBinding.Spec.RolloutOnRotation || isConsolidated(serviceBinding) || len(envTemplates(serviceBinding)) != 0
+}
+
+// envTemplates returns the env mappings, by name, whose value is rendered from a template. Mappings defined by the
+// binding take precedence over mappings from the binding type profile.
+func envTemplates(serviceBinding *servicebindingv1beta1.ServiceBinding) map[string]string {
+	mappings := []servicebindingv1beta1.EnvMapping{}
+	if serviceBinding.Status.Binding != nil {
+		mappings = append(mappings, serviceBinding.Status.Binding.Env...)
+	}
+	mappings = append(mappings, serviceBinding.Spec.Env...)
+	templates := map[string]string{}
+	for _, e := range mappings {
+		if e.Template == "" {
+			delete(templates, e.Name)
+			continue
+		}
+		templates[e.Name] = e.Template
+	}
+	return templates
+}
+
+// isConsolidated returns true when the binding is projected into the workload's consolidated volume
+func isConsolidated(serviceBinding *servicebindingv1beta1.ServiceBinding) bool {
+	return serviceBinding.Spec.Volume != nil && serviceBinding.Spec.Volume.Consolidated
+}
+
+// missingKeys returns the keys required by the binding type profile that are not defined by the secret
+func missingKeys(secret *corev1.Secret, profile *servicebindingv1beta1.ClusterBindingTypeProfile) []string {
+	if profile == nil {
+		return nil
+	}
+	return sets.NewString(profile.Spec.RequiredKeys...).Difference(sets.StringKeySet(secret.Data)).List()
+}
+
+// conflictingFilePath returns the file path of the binding that conflicts with the projected type or provider entry,
+// along with the name of the entry. The effective type and provider may be read from the binding secret, so the check
+// is deferred until they are known.
+func conflictingFilePath(binding *servicebindingv1beta1.ServiceBinding) (string, string) {
+	for _, f := range binding.Spec.Files {
+		p := f.Path
+		if p == "" {
+			p = f.Key
+		}
+		switch path.Clean(p) {
+		case "type":
+			if binding.Status.Binding.Type != "" {
+				return p, "type"
+			}
+		case "provider":
+			if binding.Status.Binding.Provider != "" {
+				return p, "provider"
+			}
+		}
+	}
+	return "", ""
+}
+
+// secretBindingType returns the binding type defined by the secret's `type` entry, or by a secret of type
+// `servicebinding.io/<type>`
+func secretBindingType(secret *corev1.Secret) string {
+	if t := string(secret.Data["type"]); t != "" {
+		return t
+	}
+	if t := string(secret.Type); strings.HasPrefix(t, BindingSecretTypePrefix) {
+		return strings.TrimPrefix(t, BindingSecretTypePrefix)
+	}
+	return ""
+}
+
+// secretHash returns a stable digest of the secret's data
+func secretHash(secret *corev1.Secret) string {
+	h := sha256.New()
+	for _, key := range sets.StringKeySet(secret.Data).List() {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write(secret.Data[key])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func ReconcileSynthesizedSecret() reconcilers.SubReconciler {
+	secretManager := ownedSecretManager("ReconcileSynthesizedSecret")
+
+	return &reconcilers.SyncReconciler{
+		Name: "ReconcileSynthesizedSecret",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			synthesized := RetrieveSynthesizedSecret(ctx)
+			actual, err := lookupOwnedSecret(ctx, c, resource, synthesizedSecretName(resource))
+			if err != nil {
+				return err
+			}
+			if !actual.CreationTimestamp.IsZero() && !metav1.IsControlledBy(actual, resource) {
+				if synthesized != nil {
+					// set False, the conflicting secret must be removed by the user
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "SynthesizedSecretConflict", "the secret %q for the synthesized binding secret is not owned by the service binding", actual.Name)
+				}
+				return nil
+			}
+
+			if synthesized == nil {
+				if resource.Status.Binding != nil && resource.Status.Binding.Name == actual.Name {
+					// the service is not resolved, keep synthesized values until it is
+					return nil
+				}
+				_, err := secretManager.Manage(ctx, resource, actual, nil)
+				return err
+			}
+			desired := desiredOwnedSecret(resource, synthesized.Name, synthesized.Data)
+			_, err = secretManager.Manage(ctx, resource, actual, desired)
+			return err
+		},
+	}
+}
+
+// synthesizedSecretName is the name of the Secret, in the service binding's namespace, that holds the binding secret
+// synthesized from a service by its ClusterServiceResourceMapping, or replicated from a service in another namespace.
+func synthesizedSecretName(resource *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", SynthesizedSecretPrefix, projector.UnitID(resource))
+}
+
+func ReconcileEnvSecret() reconcilers.SubReconciler {
+	secretManager := ownedSecretManager("ReconcileEnvSecret")
+
+	return &reconcilers.SyncReconciler{
+		Name: "ReconcileEnvSecret",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			templates := envTemplates(resource)
+			actual, err := lookupOwnedSecret(ctx, c, resource, projector.EnvSecretName(resource))
+			if err != nil {
+				return err
+			}
+			if !actual.CreationTimestamp.IsZero() && !metav1.IsControlledBy(actual, resource) {
+				if len(templates) != 0 {
+					// set False, the conflicting secret must be removed by the user
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "EnvSecretConflict", "the secret %q for templated env values is not owned by the service binding", actual.Name)
+				}
+				return nil
+			}
+
+			if len(templates) == 0 {
+				_, err := secretManager.Manage(ctx, resource, actual, nil)
+				return err
+			}
+			bindingSecret := RetrieveBindingSecret(ctx)
+			if bindingSecret == nil {
+				// the binding secret is not resolved, keep rendered values until it is
+				return nil
+			}
+
+			data, err := renderEnvTemplates(templates, bindingSecret)
+			if err != nil {
+				// set False, the template must be fixed by the user, or the missing entries added to the binding secret
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "EnvTemplateFailed", "%s", err)
+				return nil
+			}
+			desired := desiredOwnedSecret(resource, projector.EnvSecretName(resource), data)
+			_, err = secretManager.Manage(ctx, resource, actual, desired)
+			return err
+		},
+	}
+}
+
+// ownedSecretManager manages a Secret that is controlled by the service binding
+func ownedSecretManager(name string) *reconcilers.ResourceManager {
+	return &reconcilers.ResourceManager{
+		Name: name,
+		Type: &corev1.Secret{},
+		MergeBeforeUpdate: func(current, desired *corev1.Secret) {
+			current.OwnerReferences = desired.OwnerReferences
+			current.Type = desired.Type
+			current.Data = desired.Data
+		},
+		SemanticEquals: func(a1, a2 *corev1.Secret) bool {
+			return equality.Semantic.DeepEqual(a1.OwnerReferences, a2.OwnerReferences) &&
+				equality.Semantic.DeepEqual(a1.Type, a2.Type) &&
+				equality.Semantic.DeepEqual(a1.Data, a2.Data)
+		},
+		Sanitize: func(child *corev1.Secret) interface{} {
+			// never log the secret's values
+			return child.Name
+		},
+	}
+}
+
+// lookupOwnedSecret returns the named Secret in the service binding's namespace, or an empty Secret if it does not
+// exist
+func lookupOwnedSecret(ctx context.Context, c reconcilers.Config, resource *servicebindingv1beta1.ServiceBinding, name string) (*corev1.Secret, error) {
+	secretRef := corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  resource.Namespace,
+		Name:       name,
+	}
+	secret, err := resolver.New(c).LookupSecret(ctx, secretRef)
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			return nil, err
+		}
+		return &corev1.Secret{}, nil
+	}
+	return secret, nil
+}
+
+// desiredOwnedSecret returns an Opaque Secret with the data that is controlled by the service binding, or by the
+// ClusterServiceBinding the service binding is projected for
+func desiredOwnedSecret(resource *servicebindingv1beta1.ServiceBinding, name string, data map[string][]byte) *corev1.Secret {
+	owner := clusterServiceBindingRef(resource)
+	if owner == nil {
+		owner = metav1.NewControllerRef(resource, servicebindingv1beta1.GroupVersion.WithKind("ServiceBinding"))
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resource.Namespace,
+			Name:      name,
+			OwnerReferences: []metav1.OwnerReference{
+				*owner,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// renderEnvTemplates executes each template with the entries of the binding secret, keyed by env var name
+func renderEnvTemplates(templates map[string]string, secret *corev1.Secret) (map[string][]byte, error) {
+	values := map[string]string{}
+	for k, v := range secret.Data {
+		values[k] = string(v)
+	}
+	data := map[string][]byte{}
+	for _, name := range sets.StringKeySet(templates).List() {
+		t, err := template.New(name).Option("missingkey=error").Parse(templates[name])
+		if err != nil {
+			return nil, err
+		}
+		var b bytes.Buffer
+		if err := t.Execute(&b, values); err != nil {
+			return nil, err
+		}
+		data[name] = b.Bytes()
 	}
+	return data, nil
 }
 
 func ResolveWorkloads() reconcilers.SubReconciler {
@@ -123,12 +710,10 @@ func ResolveWorkloads() reconcilers.SubReconciler {
 			workloads, err := resolver.New(c).LookupWorkloads(ctx, ref, resource.Spec.Workload.Selector)
 			if err != nil {
 				if apierrs.IsNotFound(err) {
-					// leave Unknown, the workload may be created shortly
+					// leave Unknown, the workload may be created shortly. The named workload is tracked, creating the
+					// workload triggers the binding
 					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadNotFound", "the workload was not found")
-					// TODO use track rather than requeue
-					return reconcile.Result{Requeue: true}, nil
-				}
-				if apierrs.IsForbidden(err) {
+				} else if apierrs.IsForbidden(err) {
 					// set False, the operator needs to give access to the resource
 					// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac-1
 					if resource.Spec.Workload.Name == "" {
@@ -136,20 +721,57 @@ func ResolveWorkloads() reconcilers.SubReconciler {
 					} else {
 						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to get the workload")
 					}
-					// TODO use track rather than requeue
+					// access granted to the controller is not watched, requeue with backoff until it is granted
 					return reconcile.Result{Requeue: true}, nil
+				} else {
+					// TODO handle other err cases
+					return reconcile.Result{}, err
 				}
-				// TODO handle other err cases
+			}
+
+			staleWorkloads, err := lookupStaleWorkloads(ctx, c, resource, workloads)
+			if err != nil {
 				return reconcile.Result{}, err
 			}
 
 			StashWorkloads(ctx, workloads)
+			StashStaleWorkloads(ctx, staleWorkloads)
 
 			return reconcile.Result{}, nil
 		},
 	}
 }
 
+// lookupStaleWorkloads returns the workloads recorded in the binding's status that are no longer referenced by the
+// binding, like a workload whose labels no longer match the selector. A recorded workload that was deleted, or
+// recreated with a different uid, is not stale as the service was never projected into it.
+func lookupStaleWorkloads(ctx context.Context, c reconcilers.Config, resource *servicebindingv1beta1.ServiceBinding, workloads []runtime.Object) ([]runtime.Object, error) {
+	bound := sets.NewString()
+	for _, workload := range workloads {
+		bound.Insert(string(workload.(client.Object).GetUID()))
+	}
+	staleWorkloads := []runtime.Object{}
+	for _, ref := range resource.Status.Workloads {
+		if bound.Has(string(ref.UID)) {
+			continue
+		}
+		workload := &unstructured.Unstructured{}
+		workload.SetAPIVersion(ref.APIVersion)
+		workload.SetKind(ref.Kind)
+		if err := c.Get(ctx, types.NamespacedName{Namespace: resource.Namespace, Name: ref.Name}, workload); err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if workload.GetUID() != ref.UID {
+			continue
+		}
+		staleWorkloads = append(staleWorkloads, workload)
+	}
+	return staleWorkloads, nil
+}
+
 //+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=get;list;watch
 
 func ProjectBinding() reconcilers.SubReconciler {
@@ -158,26 +780,45 @@ func ProjectBinding() reconcilers.SubReconciler {
 		SyncDuringFinalization: true,
 		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
 			c := reconcilers.RetrieveConfigOrDie(ctx)
-			projector := projector.New(resolver.New(c))
+			p := projector.New(resolver.New(c))
 
 			workloads := RetrieveWorkloads(ctx)
-			projectedWorkloads := make([]runtime.Object, len(workloads))
+			staleWorkloads := RetrieveStaleWorkloads(ctx)
+			projectedWorkloads := make([]runtime.Object, len(workloads), len(workloads)+len(staleWorkloads))
+			collisions := map[types.UID]string{}
 
 			for i := range workloads {
 				workload := workloads[i].DeepCopyObject()
 				if !resource.DeletionTimestamp.IsZero() {
-					if err := projector.Unproject(ctx, resource, workload); err != nil {
+					if err := p.Unproject(ctx, resource, workload); err != nil {
 						return err
 					}
 				} else {
-					if err := projector.Project(ctx, resource, workload); err != nil {
-						return err
+					if err := p.Project(ctx, resource, workload); err != nil {
+						var collisionErr *projector.CollisionError
+						if !errors.As(err, &collisionErr) {
+							return err
+						}
+						// set False, the collision must be resolved by the user. Leave the workload as is
+						message := collisionMessage(ctx, c, resource, workloads[i].(client.Object), collisionErr)
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "ProjectionCollision", "%s", message)
+						collisions[workloads[i].(client.Object).GetUID()] = message
+						workload = workloads[i].DeepCopyObject()
 					}
 				}
 				projectedWorkloads[i] = workload
 			}
+			for i := range staleWorkloads {
+				// the workload is no longer referenced by the binding
+				workload := staleWorkloads[i].DeepCopyObject()
+				if err := p.Unproject(ctx, resource, workload); err != nil {
+					return err
+				}
+				projectedWorkloads = append(projectedWorkloads, workload)
+			}
 
 			StashProjectedWorkloads(ctx, projectedWorkloads)
+			StashProjectionCollisions(ctx, collisions)
 
 			return nil
 		},
@@ -189,6 +830,34 @@ func ProjectBinding() reconcilers.SubReconciler {
 	}
 }
 
+// collisionMessage describes the collision for the WorkloadProjected condition, resolving the uid of a conflicting
+// service binding, or cluster service binding, to its name.
+func collisionMessage(ctx context.Context, c reconcilers.Config, resource *servicebindingv1beta1.ServiceBinding, workload client.Object, err *projector.CollisionError) string {
+	owner := "the workload"
+	if err.BindingUID != "" {
+		owner = fmt.Sprintf("service binding with uid %q", err.BindingUID)
+		serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+		if listErr := c.List(ctx, serviceBindings, client.InNamespace(resource.Namespace)); listErr == nil {
+			for i := range serviceBindings.Items {
+				if uid := serviceBindings.Items[i].UID; uid == err.BindingUID || strings.HasPrefix(string(err.BindingUID), fmt.Sprintf("%s-", uid)) {
+					owner = fmt.Sprintf("service binding %q", serviceBindings.Items[i].Name)
+					break
+				}
+			}
+		}
+		clusterServiceBindings := &servicebindingv1beta1.ClusterServiceBindingList{}
+		if listErr := c.List(ctx, clusterServiceBindings); listErr == nil {
+			for i := range clusterServiceBindings.Items {
+				if uid := clusterServiceBindings.Items[i].UID; uid == err.BindingUID || strings.HasPrefix(string(err.BindingUID), fmt.Sprintf("%s-", uid)) {
+					owner = fmt.Sprintf("cluster service binding %q", clusterServiceBindings.Items[i].Name)
+					break
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%s %q in container %q of workload %q collides with %s", err.Kind, err.Name, err.Container, workload.GetName(), owner)
+}
+
 func PatchWorkloads() reconcilers.SubReconciler {
 	workloadManager := &reconcilers.ResourceManager{
 		Name: "PatchWorkloads",
@@ -204,14 +873,25 @@ func PatchWorkloads() reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name:                   "PatchWorkloads",
 		SyncDuringFinalization: true,
-		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
-			workloads := RetrieveWorkloads(ctx)
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) (reconcile.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			p := projector.New(resolver.New(c))
+			// projectors that cannot compute the base of an apply configuration update workloads as a whole
+			ap, canApply := p.(projector.ServiceBindingApplyProjector)
+			result := reconcile.Result{}
+
+			// stale workloads are unprojected after the workloads that are referenced by the binding
+			boundWorkloads := RetrieveWorkloads(ctx)
+			workloads := append(append([]runtime.Object{}, boundWorkloads...), RetrieveStaleWorkloads(ctx)...)
 			projectedWorkloads := RetrieveProjectedWorkloads(ctx)
 
 			if len(workloads) != len(projectedWorkloads) {
 				panic(fmt.Errorf("workloads and projectedWorkloads must have the same number of items"))
 			}
 
+			collisions := RetrieveProjectionCollisions(ctx)
+			// record the workloads the service is bound to, so that each is unprojected once it is no longer referenced
+			workloadStatuses := []servicebindingv1beta1.ServiceBindingWorkloadStatus{}
 			for i := range workloads {
 				workload := workloads[i].(client.Object)
 				projectedWorkload := projectedWorkloads[i].(client.Object)
@@ -219,7 +899,17 @@ func PatchWorkloads() reconcilers.SubReconciler {
 					panic(fmt.Errorf("workload and projectedWorkload must have the same uid and resourceVersion"))
 				}
 
-				if _, err := workloadManager.Manage(ctx, resource, workload, projectedWorkload); err != nil {
+				var updated client.Object
+				var err error
+				applicable := canApply && serverSideApplicable(workload)
+				if applicable {
+					updated, err = applyWorkload(ctx, ap, resource, workload, projectedWorkload)
+				}
+				if !applicable || errors.Is(err, projector.ErrAtomicList) {
+					// the projection can only be made by updating the whole workload
+					updated, err = workloadManager.Manage(ctx, resource, workload, projectedWorkload)
+				}
+				if err != nil {
 					if apierrs.IsNotFound(err) {
 						// someone must have deleted the workload while we were operating on it
 						continue
@@ -228,23 +918,348 @@ func PatchWorkloads() reconcilers.SubReconciler {
 						// set False, the operator needs to give access to the resource
 						// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac-1
 						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to update the workloads")
-						return nil
+						// a stale workload remains recorded so that unprojecting it is retried
+						status := workloadStatus(workload)
+						status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultFailed
+						status.Reason = "WorkloadForbidden"
+						status.Message = "the controller does not have permission to update the workload"
+						workloadStatuses = append(workloadStatuses, status)
+						continue
+					}
+					if isImmutableWorkloadError(err) {
+						if i >= len(boundWorkloads) {
+							// the stale workload cannot be unprojected
+							continue
+						}
+						if resource.Spec.RecreateUnstartedJobs && isUnstartedJob(workload) {
+							recreated, err := recreateWorkload(ctx, resource, workload, projectedWorkload)
+							if apierrs.IsForbidden(err) {
+								// set False, the operator needs to give access to delete and create the resource
+								resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to recreate the workloads")
+								status := workloadStatus(workload)
+								status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultFailed
+								status.Reason = "WorkloadForbidden"
+								status.Message = "the controller does not have permission to recreate the workload"
+								workloadStatuses = append(workloadStatuses, status)
+								continue
+							}
+							if apierrs.IsAlreadyExists(err) {
+								// the workload was created again by someone else after it was deleted, requeue to resolve
+								// the workload that now exists
+								result = reconcile.Result{Requeue: true}
+								continue
+							}
+							if err != nil {
+								return reconcile.Result{}, err
+							}
+							status := workloadStatus(recreated)
+							status.ObservedGeneration = recreated.GetGeneration()
+							status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultProjected
+							status.Reason = "WorkloadRecreated"
+							workloadStatuses = append(workloadStatuses, status)
+							continue
+						}
+						// leave the workload as is, the admission projector projects the service into workloads as they
+						// are created
+						status := workloadStatus(workload)
+						status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultSkipped
+						status.Reason = "WorkloadImmutable"
+						status.Message = "the pod template of the workload is immutable, the service is projected when the workload is created"
+						workloadStatuses = append(workloadStatuses, status)
+						continue
 					}
 					// TODO handle other err cases
-					return err
+					return reconcile.Result{}, err
+				}
+				if i >= len(boundWorkloads) {
+					// the stale workload is unprojected
+					continue
+				}
+
+				status := workloadStatus(updated)
+				status.ObservedGeneration = updated.GetGeneration()
+				if message, ok := collisions[workload.GetUID()]; ok {
+					status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultFailed
+					status.Reason = "ProjectionCollision"
+					status.Message = message
+				} else {
+					status.Result = servicebindingv1beta1.ServiceBindingWorkloadResultProjected
+					status.Reason = "WorkloadProjected"
 				}
+				workloadStatuses = append(workloadStatuses, status)
+			}
+
+			resource.Status.Workloads = nil
+			if resource.DeletionTimestamp.IsZero() && len(workloadStatuses) != 0 {
+				resource.Status.Workloads = workloadStatuses
 			}
+			resource.Status.WorkloadCount = int32(len(resource.Status.Workloads))
 
 			// update the WorkloadProjected condition to indicate success, but only if the condition has not already been set with another status
 			if cond := resource.Status.GetCondition(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected); apis.ConditionIsUnknown(cond) && cond.Reason == "Initializing" {
 				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadProjected", "")
 			}
 
-			return nil
+			return result, nil
 		},
 	}
 }
 
+// LegacyWorkloadFieldManager is the field manager the API server recorded for the fields of workloads that were updated
+// as a whole by earlier versions of the controller. The field manager is named for the user agent of the controller's
+// client, which defaults to the name of the controller's binary, `runtime` when built from this module. The fields are
+// migrated to the field manager of each binding as the binding is next reconciled.
+var LegacyWorkloadFieldManager = "runtime"
+
+// workloadFieldManager is the field manager that owns the fields of a workload projected by the binding. Each binding
+// owns its fields independently of the other bindings projected into the same workload.
+func workloadFieldManager(resource *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("servicebinding-%s", resource.UID)
+}
+
+// serverSideApplyKinds are the built-in workload kinds whose pod template lists are keyed by the fields that
+// projector.ApplyConfiguration keys the list items by. The schema of other kinds, like a custom resource, may declare
+// the lists as atomic, applying such a list would replace the items owned by others, like the containers of the user.
+var serverSideApplyKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Pod"}:                   true,
+	{Group: "", Kind: "PodTemplate"}:           true,
+	{Group: "", Kind: "ReplicationController"}: true,
+	{Group: "apps", Kind: "DaemonSet"}:         true,
+	{Group: "apps", Kind: "Deployment"}:        true,
+	{Group: "apps", Kind: "ReplicaSet"}:        true,
+	{Group: "apps", Kind: "StatefulSet"}:       true,
+	{Group: "batch", Kind: "CronJob"}:          true,
+	{Group: "batch", Kind: "Job"}:              true,
+}
+
+// serverSideApplicable returns true when the workload is a built-in kind and the API server tracks the managed fields
+// of the workload. The API server records the managed fields of every object it persists, they are only missing when
+// they were reset, or when the workload is served by a client that does not track them, like a fake client. Without
+// managed fields the fields the binding previously projected cannot be told apart from the fields of others, so the
+// workload is updated as a whole instead, as are workloads of other kinds.
+func serverSideApplicable(workload client.Object) bool {
+	if !serverSideApplyKinds[workload.GetObjectKind().GroupVersionKind().GroupKind()] {
+		return false
+	}
+	return len(workload.GetManagedFields()) != 0
+}
+
+// applyWorkload applies the fields the binding projects into the workload with a server-side apply patch, owned by the
+// binding's field manager. Fields that are no longer projected are removed by the API server, while fields owned by
+// other field managers, like a GitOps tool, are left as is. A projection that changes a list whose items are not keyed
+// returns projector.ErrAtomicList, as applying the list would replace items that are owned by others.
+//
+// Workloads that were projected by updating the whole workload are migrated by dropping the ownership of the
+// controller's client, so that the fields are removed once the binding no longer projects them.
+func applyWorkload(ctx context.Context, p projector.ServiceBindingApplyProjector, resource *servicebindingv1beta1.ServiceBinding, workload, projectedWorkload client.Object) (client.Object, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	pc := reconcilers.RetrieveOriginalConfigOrDie(ctx)
+
+	unprojectedWorkload := projectedWorkload.DeepCopyObject().(client.Object)
+	if err := p.UnprojectForApply(ctx, resource, unprojectedWorkload); err != nil {
+		return nil, err
+	}
+	config, err := projector.ApplyConfiguration(unprojectedWorkload, projectedWorkload)
+	if err != nil {
+		return nil, err
+	}
+
+	manager := workloadFieldManager(resource)
+	managed := false
+	legacy := false
+	managedFields := []metav1.ManagedFieldsEntry{}
+	for _, entry := range workload.GetManagedFields() {
+		switch {
+		case entry.Manager == manager:
+			managed = true
+		case entry.Manager == LegacyWorkloadFieldManager && entry.Operation == metav1.ManagedFieldsOperationUpdate:
+			legacy = true
+			continue
+		}
+		managedFields = append(managedFields, entry)
+	}
+
+	identity := &unstructured.Unstructured{}
+	identity.SetAPIVersion(config.GetAPIVersion())
+	identity.SetKind(config.GetKind())
+	identity.SetNamespace(config.GetNamespace())
+	identity.SetName(config.GetName())
+	owns := !equality.Semantic.DeepEqual(config, identity)
+
+	if !legacy && managed == owns && equality.Semantic.DeepEqual(workload, projectedWorkload) {
+		// workload is unchanged
+		return workload, nil
+	}
+
+	if legacy {
+		migrated := workload.DeepCopyObject().(client.Object)
+		if len(managedFields) == 0 {
+			// an empty list is ignored by the API server, a single empty entry resets the managed fields
+			managedFields = []metav1.ManagedFieldsEntry{{}}
+		}
+		migrated.SetManagedFields(managedFields)
+		log.Info("migrating workload managed fields", "manager", LegacyWorkloadFieldManager)
+		if err := c.Patch(ctx, migrated, client.MergeFromWithOptions(workload, client.MergeFromWithOptimisticLock{})); err != nil {
+			log.Error(err, "unable to migrate workload managed fields")
+			pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "MigrationFailed",
+				"Failed to migrate managed fields of %s %q: %v", config.GetKind(), config.GetName(), err)
+			return nil, err
+		}
+	}
+
+	log.Info("applying workload", "manager", manager, "config", config.Object)
+	if err := c.Patch(ctx, config, client.Apply, client.FieldOwner(manager), client.ForceOwnership); err != nil {
+		log.Error(err, "unable to apply workload")
+		pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "ApplyFailed",
+			"Failed to apply %s %q: %v", config.GetKind(), config.GetName(), err)
+		return nil, err
+	}
+	pc.Recorder.Eventf(resource, corev1.EventTypeNormal, "Applied",
+		"Applied %s %q", config.GetKind(), config.GetName())
+
+	return config, nil
+}
+
+// isImmutableWorkloadError returns true when the workload cannot be updated as the update changes the pod template of the
+// workload and the pod template is immutable, like the pod template of a Job. The API server rejects such an update as
+// an invalid, or forbidden, value of the `spec.template` field, or of a field within it.
+func isImmutableWorkloadError(err error) bool {
+	if !apierrs.IsInvalid(err) {
+		return false
+	}
+	var status apierrs.APIStatus
+	if !errors.As(err, &status) || status.Status().Details == nil {
+		return false
+	}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseType(field.ErrorTypeInvalid) && cause.Type != metav1.CauseType(field.ErrorTypeForbidden) {
+			continue
+		}
+		if cause.Field == "spec.template" || strings.HasPrefix(cause.Field, "spec.template.") {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnstartedJob returns true when the workload is a Job that has not started any pods and that can be deleted without
+// waiting on finalizers. A suspended Job is never unstarted, as the start time of a Job is reset when it is suspended,
+// and a suspended Job is often held by a queueing controller that tracks the Job by its uid.
+func isUnstartedJob(workload client.Object) bool {
+	if workload.GetObjectKind().GroupVersionKind().GroupKind() != (schema.GroupKind{Group: "batch", Kind: "Job"}) {
+		return false
+	}
+	if len(workload.GetFinalizers()) != 0 || !workload.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+	if err != nil {
+		return false
+	}
+	if suspend, _, _ := unstructured.NestedBool(u, "spec", "suspend"); suspend {
+		return false
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u, "status", "startTime"); ok {
+		return false
+	}
+	for _, pods := range []string{"active", "succeeded", "failed"} {
+		if count, _, _ := unstructured.NestedInt64(u, "status", pods); count != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// recreateWorkload deletes the workload and creates it again as projected. The workload is only deleted when it is
+// unchanged since it was resolved.
+func recreateWorkload(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding, workload, projectedWorkload client.Object) (client.Object, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	pc := reconcilers.RetrieveOriginalConfigOrDie(ctx)
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(projectedWorkload)
+	if err != nil {
+		return nil, err
+	}
+	recreated := &unstructured.Unstructured{Object: u}
+	recreated.SetUID("")
+	recreated.SetResourceVersion("")
+	recreated.SetCreationTimestamp(metav1.Time{})
+	recreated.SetManagedFields(nil)
+	if manualSelector, _, _ := unstructured.NestedBool(recreated.Object, "spec", "manualSelector"); !manualSelector {
+		// the selector, and the pod template labels it matches, are generated for the new uid of the Job
+		unstructured.RemoveNestedField(recreated.Object, "spec", "selector")
+		for _, label := range []string{"controller-uid", "job-name", "batch.kubernetes.io/controller-uid", "batch.kubernetes.io/job-name"} {
+			unstructured.RemoveNestedField(recreated.Object, "spec", "template", "metadata", "labels", label)
+		}
+	}
+
+	uid := workload.GetUID()
+	resourceVersion := workload.GetResourceVersion()
+	log.Info("deleting workload to recreate it")
+	if err := c.Delete(ctx, workload, client.Preconditions{UID: &uid, ResourceVersion: &resourceVersion}, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		log.Error(err, "unable to delete workload")
+		pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "DeleteFailed",
+			"Failed to delete %s %q: %v", recreated.GetKind(), recreated.GetName(), err)
+		return nil, err
+	}
+	if err := c.Create(ctx, recreated); err != nil {
+		log.Error(err, "unable to recreate workload")
+		pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "CreationFailed",
+			"Failed to recreate %s %q: %v", recreated.GetKind(), recreated.GetName(), err)
+		return nil, err
+	}
+	pc.Recorder.Eventf(resource, corev1.EventTypeNormal, "Recreated",
+		"Recreated %s %q", recreated.GetKind(), recreated.GetName())
+
+	return recreated, nil
+}
+
+// workloadStatus references the workload from the binding's status
+func workloadStatus(workload client.Object) servicebindingv1beta1.ServiceBindingWorkloadStatus {
+	apiVersion, kind := workload.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+	return servicebindingv1beta1.ServiceBindingWorkloadStatus{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       workload.GetName(),
+		UID:        workload.GetUID(),
+	}
+}
+
+// BindingSecretTypePrefix prefixes the binding type in the type of a binding secret, like `servicebinding.io/postgresql`
+const BindingSecretTypePrefix = "servicebinding.io/"
+
+const SynthesizedSecretPrefix = "servicebinding-secret-"
+
+const SynthesizedSecretStashKey reconcilers.StashKey = "servicebinding.io:synthesized-secret"
+
+func StashSynthesizedSecret(ctx context.Context, secret *corev1.Secret) {
+	reconcilers.StashValue(ctx, SynthesizedSecretStashKey, secret)
+}
+
+func RetrieveSynthesizedSecret(ctx context.Context) *corev1.Secret {
+	value := reconcilers.RetrieveValue(ctx, SynthesizedSecretStashKey)
+	if secret, ok := value.(*corev1.Secret); ok {
+		return secret
+	}
+	return nil
+}
+
+const BindingSecretStashKey reconcilers.StashKey = "servicebinding.io:binding-secret"
+
+func StashBindingSecret(ctx context.Context, secret *corev1.Secret) {
+	reconcilers.StashValue(ctx, BindingSecretStashKey, secret)
+}
+
+func RetrieveBindingSecret(ctx context.Context) *corev1.Secret {
+	value := reconcilers.RetrieveValue(ctx, BindingSecretStashKey)
+	if secret, ok := value.(*corev1.Secret); ok {
+		return secret
+	}
+	return nil
+}
+
 const WorkloadsStashKey reconcilers.StashKey = "servicebinding.io:workloads"
 
 func StashWorkloads(ctx context.Context, workloads []runtime.Object) {
@@ -259,6 +1274,36 @@ func RetrieveWorkloads(ctx context.Context) []runtime.Object {
 	return nil
 }
 
+const StaleWorkloadsStashKey reconcilers.StashKey = "servicebinding.io:stale-workloads"
+
+func StashStaleWorkloads(ctx context.Context, workloads []runtime.Object) {
+	reconcilers.StashValue(ctx, StaleWorkloadsStashKey, workloads)
+}
+
+func RetrieveStaleWorkloads(ctx context.Context) []runtime.Object {
+	value := reconcilers.RetrieveValue(ctx, StaleWorkloadsStashKey)
+	if workloads, ok := value.([]runtime.Object); ok {
+		return workloads
+	}
+	return nil
+}
+
+const ProjectionCollisionsStashKey reconcilers.StashKey = "servicebinding.io:projection-collisions"
+
+// StashProjectionCollisions stashes the message describing the collision for each workload, by uid, that the binding
+// could not be projected into
+func StashProjectionCollisions(ctx context.Context, collisions map[types.UID]string) {
+	reconcilers.StashValue(ctx, ProjectionCollisionsStashKey, collisions)
+}
+
+func RetrieveProjectionCollisions(ctx context.Context) map[types.UID]string {
+	value := reconcilers.RetrieveValue(ctx, ProjectionCollisionsStashKey)
+	if collisions, ok := value.(map[types.UID]string); ok {
+		return collisions
+	}
+	return nil
+}
+
 const ProjectedWorkloadsStashKey reconcilers.StashKey = "servicebinding.io:projected-workloads"
 
 func StashProjectedWorkloads(ctx context.Context, workloads []runtime.Object) {
@@ -18,16 +18,20 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"reflect"
 
 	"github.com/go-logr/logr"
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	"github.com/vmware-labs/reconciler-runtime/tracker"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -104,19 +108,41 @@ func AdmissionProjectorReconciler(c reconcilers.Config, name string, accessCheck
 			}); err != nil {
 				return err
 			}
+			if err := mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1beta1.ClusterServiceBinding{}, workloadRefIndexKey, func(obj client.Object) []string {
+				clusterServiceBinding := obj.(*servicebindingv1beta1.ClusterServiceBinding)
+				gvk := schema.FromAPIVersionAndKind(clusterServiceBinding.Spec.Workload.APIVersion, clusterServiceBinding.Spec.Workload.Kind)
+				return []string{workloadRefIndexValue(gvk.Group, gvk.Kind)}
+			}); err != nil {
+				return err
+			}
 			return nil
 		},
 		Config: c,
 	}
 }
 
-func AdmissionProjectorWebhook(c reconcilers.Config) *reconcilers.AdmissionWebhookAdapter {
+// AdmissionProjectorWebhook projects the service bindings, and cluster service bindings, for the workload being admitted
+// into the workload. Each binding projected into the workload is enqueued, so that the workload is reflected onto the
+// binding's status.
+func AdmissionProjectorWebhook(c reconcilers.Config, serviceBindingController controller.Controller, clusterServiceBindingController controller.Controller) *reconcilers.AdmissionWebhookAdapter {
 	return &reconcilers.AdmissionWebhookAdapter{
 		Name: "AdmissionProjectorWebhook",
 		Type: &unstructured.Unstructured{},
 		Reconciler: &reconcilers.SyncReconciler{
 			Sync: func(ctx context.Context, workload *unstructured.Unstructured) error {
 				c := reconcilers.RetrieveConfigOrDie(ctx)
+				log := logr.FromContextOrDiscard(ctx)
+				req := reconcilers.RetrieveAdmissionRequest(ctx)
+
+				// on update, the bindings that matched the workload before it was updated are enqueued so they are
+				// unprojected from the workload when they no longer match
+				var previous *unstructured.Unstructured
+				if len(req.OldObject.Raw) != 0 {
+					previous = &unstructured.Unstructured{}
+					if err := previous.UnmarshalJSON(req.OldObject.Raw); err != nil {
+						return err
+					}
+				}
 
 				// find matching service bindings
 				serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
@@ -127,34 +153,83 @@ func AdmissionProjectorWebhook(c reconcilers.Config) *reconcilers.AdmissionWebho
 
 				// check that bindings are for this workload
 				activeServiceBindings := []servicebindingv1beta1.ServiceBinding{}
+				projected := []types.NamespacedName{}
 				for _, sb := range serviceBindings.Items {
 					if !sb.DeletionTimestamp.IsZero() {
 						continue
 					}
-					ref := sb.Spec.Workload
-					if ref.Name == workload.GetName() {
+					if matchesWorkload(sb.Spec.Workload, workload) {
 						activeServiceBindings = append(activeServiceBindings, sb)
-						continue
+						projected = append(projected, client.ObjectKeyFromObject(&sb))
+					} else if previous != nil && matchesWorkload(sb.Spec.Workload, previous) {
+						projected = append(projected, client.ObjectKeyFromObject(&sb))
 					}
-					if ref.Selector != nil {
-						selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
-						if err != nil {
+				}
+
+				// find matching cluster service bindings that select the workload's namespace
+				clusterServiceBindings := &servicebindingv1beta1.ClusterServiceBindingList{}
+				if err := c.List(ctx, clusterServiceBindings, client.MatchingFields{workloadRefIndexKey: workloadRefIndexValue(gvk.Group, gvk.Kind)}); err != nil {
+					return err
+				}
+				clusterProjected := []types.NamespacedName{}
+				if len(clusterServiceBindings.Items) != 0 {
+					namespace := &corev1.Namespace{}
+					if err := c.Get(ctx, types.NamespacedName{Name: workload.GetNamespace()}, namespace); err != nil {
+						return err
+					}
+					for i := range clusterServiceBindings.Items {
+						csb := &clusterServiceBindings.Items[i]
+						if !csb.DeletionTimestamp.IsZero() || !csb.SelectsNamespace(&namespace.ObjectMeta) {
 							continue
 						}
-						if selector.Matches(labels.Set(workload.GetLabels())) {
-							activeServiceBindings = append(activeServiceBindings, sb)
-							continue
+						if matchesWorkload(csb.Spec.Workload, workload) {
+							activeServiceBindings = append(activeServiceBindings, *csb.ServiceBinding(workload.GetNamespace()))
+							clusterProjected = append(clusterProjected, client.ObjectKeyFromObject(csb))
+						} else if previous != nil && matchesWorkload(csb.Spec.Workload, previous) {
+							clusterProjected = append(clusterProjected, client.ObjectKeyFromObject(csb))
 						}
 					}
 				}
 
 				// project active bindings into workload
-				projector := projector.New(resolver.New(c))
+				p := projector.New(resolver.New(c))
 				for i := range activeServiceBindings {
 					sb := activeServiceBindings[i].DeepCopy()
 					sb.Default()
-					if err := projector.Project(ctx, sb, workload); err != nil {
-						return err
+					projected := workload.DeepCopy()
+					if err := p.Project(ctx, sb, projected); err != nil {
+						var collisionErr *projector.CollisionError
+						if !errors.As(err, &collisionErr) {
+							return err
+						}
+						// admit the workload without the colliding binding, the binding is enqueued and reports the
+						// collision on its status
+						log.Info("skipping colliding service binding", "serviceBinding", client.ObjectKeyFromObject(sb), "collision", collisionErr.Error())
+						continue
+					}
+					workload.SetUnstructuredContent(projected.UnstructuredContent())
+				}
+
+				for _, t := range []struct {
+					controller controller.Controller
+					projected  []types.NamespacedName
+				}{
+					{controller: serviceBindingController, projected: projected},
+					{controller: clusterServiceBindingController, projected: clusterProjected},
+				} {
+					queue := controllerQueue(t.controller)
+					if queue == nil {
+						// queue is not populated yet
+						continue
+					}
+					for _, nsn := range t.projected {
+						rr := reconcile.Request{NamespacedName: nsn}
+						log.V(2).Info("enqueue projected request", "request", rr, "dryRun", req.DryRun)
+						if req.DryRun != nil && *req.DryRun {
+							// ignore dry run requests
+							continue
+						}
+						queue.Add(rr)
 					}
 				}
 
@@ -165,6 +240,21 @@ func AdmissionProjectorWebhook(c reconcilers.Config) *reconcilers.AdmissionWebho
 	}
 }
 
+// matchesWorkload returns true when the workload is referenced by name, or selected by labels
+func matchesWorkload(ref servicebindingv1beta1.ServiceBindingWorkloadReference, workload client.Object) bool {
+	if ref.Name == workload.GetName() {
+		return true
+	}
+	if ref.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err != nil {
+			return false
+		}
+		return selector.Matches(labels.Set(workload.GetLabels()))
+	}
+	return false
+}
+
 //+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 
@@ -183,6 +273,8 @@ func TriggerReconciler(c reconcilers.Config, name string, accessChecker rbac.Acc
 		Reconciler: reconcilers.Sequence{
 			LoadServiceBindings(req),
 			TriggerGVKs(),
+			// the workload kinds are triggers too, creating a named workload, or a workload matching a binding's
+			// selector, enqueues the binding
 			InterceptGVKs(),
 			WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update, admissionregistrationv1.Delete}, accessChecker),
 		},
@@ -216,28 +308,34 @@ func TriggerReconciler(c reconcilers.Config, name string, accessChecker rbac.Acc
 			return resource.Webhooks[0].Rules
 		},
 
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			if err := mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1beta1.ServiceBinding{}, selectorRefIndexKey, func(obj client.Object) []string {
+				return selectorRefIndexValues(obj.(*servicebindingv1beta1.ServiceBinding))
+			}); err != nil {
+				return err
+			}
+			if err := mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1beta1.ClusterServiceBinding{}, selectorRefIndexKey, func(obj client.Object) []string {
+				return selectorRefIndexValues(obj.(*servicebindingv1beta1.ClusterServiceBinding).ServiceBinding(""))
+			}); err != nil {
+				return err
+			}
+			return nil
+		},
 		Config: c,
 	}
 }
 
-func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Controller) *reconcilers.AdmissionWebhookAdapter {
+// TriggerWebhook enqueues the service bindings, and cluster service bindings, that track, or select, the object being
+// admitted. Each controller is enqueued with the requests tracked by its config.
+func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Controller, clusterServiceBindingConfig reconcilers.Config, clusterServiceBindingController controller.Controller) *reconcilers.AdmissionWebhookAdapter {
 	return &reconcilers.AdmissionWebhookAdapter{
 		Name: "AdmissionProjectorWebhook",
 		Type: &unstructured.Unstructured{},
 		Reconciler: &reconcilers.SyncReconciler{
 			Sync: func(ctx context.Context, trigger *unstructured.Unstructured) error {
 				log := logr.FromContextOrDiscard(ctx)
-				c := reconcilers.RetrieveConfigOrDie(ctx)
 				req := reconcilers.RetrieveAdmissionRequest(ctx)
 
-				// TODO find a better way to get at the queue, this is fragile and may break in any controller-runtime update
-				queueValue := reflect.ValueOf(serviceBindingController).Elem().FieldByName("Queue")
-				if queueValue.IsNil() {
-					// queue is not populated yet
-					return nil
-				}
-				queue := queueValue.Interface().(workqueue.Interface)
-
 				trackKey := tracker.NewKey(
 					schema.FromAPIVersionAndKind(trigger.GetAPIVersion(), trigger.GetKind()),
 					types.NamespacedName{
@@ -245,14 +343,33 @@ func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Co
 						Name:      trigger.GetName(),
 					},
 				)
-				for _, nsn := range c.Tracker.Lookup(ctx, trackKey) {
-					rr := reconcile.Request{NamespacedName: nsn}
-					log.V(2).Info("enqueue tracked request", "request", rr, "for", trackKey, "dryRun", req.DryRun)
-					if req.DryRun != nil && *req.DryRun {
-						// ignore dry run requests
+				serviceBindings, clusterServiceBindings, err := selectingServiceBindings(ctx, c, trigger, req.OldObject)
+				if err != nil {
+					return err
+				}
+				for _, t := range []struct {
+					config     reconcilers.Config
+					controller controller.Controller
+					selecting  []types.NamespacedName
+				}{
+					{config: c, controller: serviceBindingController, selecting: serviceBindings},
+					{config: clusterServiceBindingConfig, controller: clusterServiceBindingController, selecting: clusterServiceBindings},
+				} {
+					queue := controllerQueue(t.controller)
+					if queue == nil {
+						// queue is not populated yet
 						continue
 					}
-					queue.Add(rr)
+
+					for _, nsn := range append(t.config.Tracker.Lookup(ctx, trackKey), t.selecting...) {
+						rr := reconcile.Request{NamespacedName: nsn}
+						log.V(2).Info("enqueue tracked request", "request", rr, "for", trackKey, "dryRun", req.DryRun)
+						if req.DryRun != nil && *req.DryRun {
+							// ignore dry run requests
+							continue
+						}
+						queue.Add(rr)
+					}
 				}
 
 				return nil
@@ -262,6 +379,114 @@ func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Co
 	}
 }
 
+// controllerQueue returns the work queue of the controller, or nil when the queue is not populated yet
+func controllerQueue(ctrl controller.Controller) workqueue.Interface {
+	// TODO find a better way to get at the queue, this is fragile and may break in any controller-runtime update
+	queueValue := reflect.ValueOf(ctrl).Elem().FieldByName("Queue")
+	if queueValue.IsNil() {
+		return nil
+	}
+	return queueValue.Interface().(workqueue.Interface)
+}
+
+// selectingServiceBindings returns the service bindings, and cluster service bindings, with a service or workload
+// selector that matches the labels of the object being admitted, or the labels of the object before it was updated.
+// Changing the labels of a service may change which service a binding selects, while creating a workload, or changing
+// its labels, may change which workloads a binding is projected into. Unlike a named resource, a selected resource
+// that does not exist yet cannot be tracked.
+func selectingServiceBindings(ctx context.Context, c reconcilers.Config, trigger *unstructured.Unstructured, old runtime.RawExtension) ([]types.NamespacedName, []types.NamespacedName, error) {
+	objs := []*unstructured.Unstructured{trigger}
+	if len(old.Raw) != 0 {
+		previous := &unstructured.Unstructured{}
+		if err := previous.UnmarshalJSON(old.Raw); err != nil {
+			return nil, nil, err
+		}
+		objs = append(objs, previous)
+	}
+	selects := func(serviceBinding *servicebindingv1beta1.ServiceBinding) bool {
+		for _, obj := range objs {
+			if selectsWorkload(serviceBinding.Spec.Workload, serviceBinding.Namespace, obj) {
+				return true
+			}
+		}
+		for _, unit := range projector.ServiceUnits(serviceBinding) {
+			for _, obj := range objs {
+				if selectsService(unit.Spec.Service, unit.Namespace, obj) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// only bindings that select resources of the trigger's kind are listed
+	gvk := trigger.GroupVersionKind()
+	selectorRef := client.MatchingFields{selectorRefIndexKey: selectorRefIndexValue(gvk.Group, gvk.Kind)}
+
+	serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+	if err := c.List(ctx, serviceBindings, selectorRef); err != nil {
+		return nil, nil, err
+	}
+	selecting := []types.NamespacedName{}
+	for i := range serviceBindings.Items {
+		if selects(&serviceBindings.Items[i]) {
+			selecting = append(selecting, client.ObjectKeyFromObject(&serviceBindings.Items[i]))
+		}
+	}
+
+	clusterServiceBindings := &servicebindingv1beta1.ClusterServiceBindingList{}
+	if err := c.List(ctx, clusterServiceBindings, selectorRef); err != nil {
+		return nil, nil, err
+	}
+	clusterSelecting := []types.NamespacedName{}
+	for i := range clusterServiceBindings.Items {
+		if selects(clusterServiceBindings.Items[i].ServiceBinding("")) {
+			clusterSelecting = append(clusterSelecting, client.ObjectKeyFromObject(&clusterServiceBindings.Items[i]))
+		}
+	}
+
+	return selecting, clusterSelecting, nil
+}
+
+// selectsService returns true when the service reference has a selector that matches the labels of the object. The
+// service is expected in the binding's namespace unless the reference defines a namespace.
+func selectsService(ref servicebindingv1beta1.ServiceBindingServiceReference, namespace string, obj client.Object) bool {
+	if ref.Selector == nil {
+		return false
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	if obj.GetNamespace() != namespace || schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind() != obj.GetObjectKind().GroupVersionKind().GroupKind() {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// selectsWorkload returns true when the workload reference has a selector that matches the labels of the object. The
+// workload is expected in the binding's namespace, a cluster service binding, without a namespace, selects workloads in
+// each namespace as the namespace selector is checked when the binding is reconciled.
+func selectsWorkload(ref servicebindingv1beta1.ServiceBindingWorkloadReference, namespace string, obj client.Object) bool {
+	if ref.Selector == nil {
+		return false
+	}
+	if namespace != "" && obj.GetNamespace() != namespace {
+		return false
+	}
+	if schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind() != obj.GetObjectKind().GroupVersionKind().GroupKind() {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(obj.GetLabels()))
+}
+
 func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name: "LoadServiceBindings",
@@ -273,6 +498,15 @@ func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler {
 				return err
 			}
 
+			clusterServiceBindings := &servicebindingv1beta1.ClusterServiceBindingList{}
+			if err := c.List(ctx, clusterServiceBindings); err != nil {
+				return err
+			}
+			for i := range clusterServiceBindings.Items {
+				// a cluster binding references the same resources regardless of the namespace it is projected into
+				serviceBindings.Items = append(serviceBindings.Items, *clusterServiceBindings.Items[i].ServiceBinding(""))
+			}
+
 			StashServiceBindings(ctx, serviceBindings.Items)
 
 			return nil
@@ -283,6 +517,11 @@ func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler {
 					return []reconcile.Request{req}
 				},
 			))
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterServiceBinding{}}, handler.EnqueueRequestsFromMapFunc(
+				func(o client.Object) []reconcile.Request {
+					return []reconcile.Request{req}
+				},
+			))
 			return nil
 		},
 	}
@@ -312,17 +551,52 @@ func TriggerGVKs() reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name: "TriggerGVKs",
 		Sync: func(ctx context.Context, _ client.Object) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
 			serviceBindings := RetrieveServiceBindings(ctx)
 			gvks := RetrieveObservedGKVs(ctx)
 
 			for i := range serviceBindings {
-				service := serviceBindings[i].Spec.Service
-				gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
-				if gvk.Kind == "Secret" && (gvk.Group == "" || gvk.Group == "core") {
-					// ignore direct bindings
-					continue
+				for _, serviceBinding := range projector.ServiceUnits(&serviceBindings[i]) {
+					service := serviceBinding.Spec.Service
+					gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
+					serviceRef := corev1.ObjectReference{
+						APIVersion: service.APIVersion,
+						Kind:       service.Kind,
+						Namespace:  serviceBinding.Namespace,
+						Name:       service.Name,
+					}
+					if service.Namespace != "" {
+						serviceRef.Namespace = service.Namespace
+					}
+					if gvk.Kind == "Secret" && (gvk.Group == "" || gvk.Group == "core") {
+						// the directly referenced secret is tracked, so that deleting or creating the secret updates the binding
+						gvks = append(gvks, corev1.SchemeGroupVersion.WithKind("Secret"))
+						continue
+					}
+					if readsBindingSecret(serviceBinding) {
+						// the content of the binding secret is projected
+						gvks = append(gvks, corev1.SchemeGroupVersion.WithKind("Secret"))
+					} else if serviceRef.Namespace != serviceBinding.Namespace {
+						// the binding secret is replicated from the service's namespace
+						gvks = append(gvks, corev1.SchemeGroupVersion.WithKind("Secret"))
+					} else if mapping, err := resolver.New(c).LookupServiceMapping(ctx, serviceRef); err != nil {
+						return err
+					} else if mapping != nil && mapping.Secret != nil {
+						// the binding secret is synthesized with entries from secrets referenced by the service
+						gvks = append(gvks, corev1.SchemeGroupVersion.WithKind("Secret"))
+					} else if bindingType := serviceBinding.Spec.Type; bindingType != "" {
+						profile := &servicebindingv1beta1.ClusterBindingTypeProfile{}
+						if err := c.Get(ctx, types.NamespacedName{Name: bindingType}, profile); err != nil {
+							if !apierrs.IsNotFound(err) {
+								return err
+							}
+						} else if len(profile.Spec.RequiredKeys) != 0 {
+							// the keys of the binding secret are checked against the binding type profile
+							gvks = append(gvks, corev1.SchemeGroupVersion.WithKind("Secret"))
+						}
+					}
+					gvks = append(gvks, gvk)
 				}
-				gvks = append(gvks, gvk)
 			}
 
 			StashObservedGVKs(ctx, gvks)
@@ -442,3 +716,26 @@ const workloadRefIndexKey = ".metadata.workloadRef"
 func workloadRefIndexValue(group, kind string) string {
 	return schema.GroupKind{Group: group, Kind: kind}.String()
 }
+
+const selectorRefIndexKey = ".spec.selectorRef"
+
+func selectorRefIndexValue(group, kind string) string {
+	return schema.GroupKind{Group: group, Kind: kind}.String()
+}
+
+// selectorRefIndexValues returns the index values for the group/kinds of the services, and workloads, the binding
+// selects by labels. Resources referenced by name are tracked rather than selected.
+func selectorRefIndexValues(serviceBinding *servicebindingv1beta1.ServiceBinding) []string {
+	values := sets.NewString()
+	if workload := serviceBinding.Spec.Workload; workload.Selector != nil {
+		gvk := schema.FromAPIVersionAndKind(workload.APIVersion, workload.Kind)
+		values.Insert(selectorRefIndexValue(gvk.Group, gvk.Kind))
+	}
+	for _, unit := range projector.ServiceUnits(serviceBinding) {
+		if service := unit.Spec.Service; service.Selector != nil {
+			gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
+			values.Insert(selectorRefIndexValue(gvk.Group, gvk.Kind))
+		}
+	}
+	return values.List()
+}
@@ -43,6 +43,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -185,6 +186,7 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 
 	requestUID := types.UID("9deefaa1-2c90-4f40-9c7b-3f5c1fd75dde")
 	bindingUID := types.UID("89deaf20-7bab-4610-81db-6f8c3f7fa51d")
+	clusterBindingUID := types.UID("f1d4c3d5-6f4e-4a7b-9a36-2a1e4b7a5c0e")
 
 	workload := dieappsv1.DeploymentBlank.
 		APIVersion("apps/v1").
@@ -214,6 +216,36 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 			})
 		})
 
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+			d.UID(clusterBindingUID)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+				d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("apps/v1")
+					d.Kind("Deployment")
+					d.Name(name)
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+				d.AddMatchLabel("tenant", "true")
+			})
+		}).
+		StatusDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingStatusDie) {
+			d.ServiceBindingStatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+				d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+					d.Name(secret)
+				})
+			})
+		})
+	workloadNamespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(namespace)
+			d.AddLabel("tenant", "true")
+		})
+
 	request := dieadmissionv1.AdmissionRequestBlank.
 		UID(requestUID).
 		Operation(admissionv1.Create)
@@ -370,6 +402,97 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 					},
 				},
 			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+				},
+			},
+		},
+		"cluster binding projected by name": {
+			GivenObjects: []client.Object{
+				clusterServiceBinding,
+				workloadNamespace,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/spec/template/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/secret-%s", clusterBindingUID): secret,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/env",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":  "SERVICE_BINDING_ROOT",
+								"value": "/bindings",
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/volumeMounts",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":      fmt.Sprintf("servicebinding-%s", clusterBindingUID),
+								"mountPath": "/bindings/my-workload",
+								"readOnly":  true,
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/volumes",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": fmt.Sprintf("servicebinding-%s", clusterBindingUID),
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": secret,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Metadata: map[string]interface{}{
+				"clusterQueue": workqueue.New(),
+				"expectedClusterRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Name: name}},
+				},
+			},
+		},
+		"cluster binding for a namespace that is not selected": {
+			GivenObjects: []client.Object{
+				clusterServiceBinding,
+				workloadNamespace.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Labels(nil)
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
 		},
 		"binding projected by selector": {
 			GivenObjects: []client.Object{
@@ -438,6 +561,155 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 				},
 			},
 		},
+		"binding projected with volume options": {
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(name)
+					})
+					d.VolumeDie(func(d *dieservicebindingv1beta1.VolumeOptionsDie) {
+						d.MountPath("/etc/certs")
+						d.DefaultMode(pointer.Int32(0400))
+					})
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/spec/template/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID): secret,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/env",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":  "SERVICE_BINDING_ROOT",
+								"value": "/bindings",
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/volumeMounts",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":      fmt.Sprintf("servicebinding-%s", bindingUID),
+								"mountPath": "/etc/certs",
+								"readOnly":  true,
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/volumes",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": fmt.Sprintf("servicebinding-%s", bindingUID),
+								"projected": map[string]interface{}{
+									"defaultMode": float64(0400),
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": secret,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"skip colliding binding": {
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(name)
+					})
+					d.EnvDie("username", func(d *dieservicebindingv1beta1.EnvMappingDie) {
+						d.Name("USERNAME")
+					})
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(
+						workload.
+							SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+								d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+									d.SpecDie(func(d *diecorev1.PodSpecDie) {
+										d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+											d.EnvDie("USERNAME", func(d *diecorev1.EnvVarDie) {
+												d.Value("admin")
+											})
+										})
+									})
+								})
+							}).
+							DieReleaseRawExtension(),
+					).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+				},
+			},
+		},
+		"enqueue binding no longer selecting the updated workload": {
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("bound", "true")
+						})
+					})
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Operation(admissionv1.Update).
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(
+						workload.
+							MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.AddLabel("bound", "true")
+							}).
+							DieReleaseRawExtension(),
+					).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+				},
+			},
+		},
 		"ingore terminating bindings": {
 			GivenObjects: []client.Object{
 				serviceBinding.
@@ -485,7 +757,38 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 	wts.Run(t, scheme, func(t *testing.T, wtc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.AdmissionProjectorWebhook(c).Build()
+
+		if wtc.Metadata == nil {
+			wtc.Metadata = map[string]interface{}{}
+		}
+		wtc.CleanUp = func(t *testing.T, wtc *rtesting.AdmissionWebhookTestCase) error {
+			for queueKey, expectedKey := range map[string]string{"queue": "expectedRequests", "clusterQueue": "expectedClusterRequests"} {
+				queue, ok := wtc.Metadata[queueKey].(workqueue.Interface)
+				if !ok {
+					continue
+				}
+				actualRequests := []reconcile.Request{}
+				for len(actualRequests) < queue.Len() {
+					request, _ := queue.Get()
+					actualRequests = append(actualRequests, request.(reconcile.Request))
+				}
+				expectedRequests := wtc.Metadata[expectedKey].([]reconcile.Request)
+				if diff := cmp.Diff(expectedRequests, actualRequests); diff != "" {
+					t.Errorf("enqueued request for %s (-expected, +actual): %s", queueKey, diff)
+				}
+			}
+			return nil
+		}
+
+		queue, _ := wtc.Metadata["queue"].(workqueue.Interface)
+		ctrl := &mockController{
+			Queue: queue,
+		}
+		clusterQueue, _ := wtc.Metadata["clusterQueue"].(workqueue.Interface)
+		clusterCtrl := &mockController{
+			Queue: clusterQueue,
+		}
+		return controllers.AdmissionProjectorWebhook(c, ctrl, clusterCtrl).Build()
 	})
 }
 
@@ -546,6 +849,8 @@ func TestTriggerReconciler(t *testing.T) {
 				d.Kind("Deployment")
 				d.Name("my-workload")
 			})
+			d.Type("mysql")
+			d.Provider("bitnami")
 		})
 
 	rts := rtesting.ReconcilerTestSuite{{
@@ -588,31 +893,78 @@ func TestTriggerReconciler(t *testing.T) {
 			webhook,
 		},
 	}, {
-		Name: "ignore other keys",
-		Key: types.NamespacedName{
-			Name: "other-webhook",
-		},
+		Name: "selected workload kinds",
+		Key:  key,
 		GivenObjects: []client.Object{
 			webhook.
 				WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
 					d.Rules()
 				}),
-			serviceBinding,
-		},
-	}, {
-		Name: "ignore malformed webhook",
-		Key:  key,
-		GivenObjects: []client.Object{
-			webhook.
-				Webhooks(),
-			serviceBinding,
+			serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("example/v1")
+						d.Kind("MyWorkload")
+						d.Name("")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my-workload")
+						})
+					})
+				}),
 		},
 		WithReactors: []rtesting.ReactionFunc{
-			allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
 			allowSelfSubjectAccessReviewFor("example", "myservices", "get"),
+			allowSelfSubjectAccessReviewFor("example", "myworkloads", "get"),
+		},
+		ExpectEvents: []rtesting.Event{
+			rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
 		},
 		ExpectCreates: []client.Object{
-			selfSubjectAccessReviewFor("apps", "deployments", "get"),
+			selfSubjectAccessReviewFor("example", "myservices", "get"),
+			selfSubjectAccessReviewFor("example", "myworkloads", "get"),
+		},
+		ExpectUpdates: []client.Object{
+			webhook.
+				WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+					d.RulesDie(
+						dieadmissionregistrationv1.RuleWithOperationsBlank.
+							APIGroups("example").
+							APIVersions("*").
+							Resources("myservices", "myworkloads").
+							Operations(
+								admissionregistrationv1.Create,
+								admissionregistrationv1.Update,
+								admissionregistrationv1.Delete,
+							),
+					)
+				}),
+		},
+	}, {
+		Name: "ignore other keys",
+		Key: types.NamespacedName{
+			Name: "other-webhook",
+		},
+		GivenObjects: []client.Object{
+			webhook.
+				WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+					d.Rules()
+				}),
+			serviceBinding,
+		},
+	}, {
+		Name: "ignore malformed webhook",
+		Key:  key,
+		GivenObjects: []client.Object{
+			webhook.
+				Webhooks(),
+			serviceBinding,
+		},
+		WithReactors: []rtesting.ReactionFunc{
+			allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+			allowSelfSubjectAccessReviewFor("example", "myservices", "get"),
+		},
+		ExpectCreates: []client.Object{
+			selfSubjectAccessReviewFor("apps", "deployments", "get"),
 			selfSubjectAccessReviewFor("example", "myservices", "get"),
 		},
 	}}
@@ -621,6 +973,7 @@ func TestTriggerReconciler(t *testing.T) {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyService"}, meta.RESTScopeNamespace)
+		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyWorkload"}, meta.RESTScopeNamespace)
 		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("get")
 		return controllers.TriggerReconciler(c, name, accessChecker)
 	})
@@ -643,6 +996,11 @@ func TestTriggerWebhook(t *testing.T) {
 			d.Name(bindingName)
 		})
 
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(bindingName)
+		})
+
 	workload := dieappsv1.DeploymentBlank.
 		APIVersion("apps/v1").
 		Kind("Deployment").
@@ -706,24 +1064,275 @@ func TestTriggerWebhook(t *testing.T) {
 				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
 			},
 		},
+		"enqueue service binding selecting the object": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.Selector(&metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "my-database"},
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-database")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
+				},
+			},
+		},
+		"enqueue service binding that selected the object before it was updated": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.Selector(&metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "my-database"},
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Operation(admissionv1.Update).
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-database")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
+				},
+			},
+		},
+		"enqueue service binding selecting the created workload": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+								d.AddMatchLabel("app", "my-workload")
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-workload")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
+				},
+			},
+		},
+		"enqueue service binding that selected the workload before it was updated": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+								d.AddMatchLabel("app", "my-workload")
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Operation(admissionv1.Update).
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-workload")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.New(),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
+				},
+			},
+		},
+		"ignore service binding selecting workloads in another namespace": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Namespace("other-namespace")
+					}).
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+								d.AddMatchLabel("app", "my-workload")
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-workload")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue":            workqueue.New(),
+				"expectedRequests": []reconcile.Request{},
+			},
+		},
+		"enqueue cluster service binding selecting the created workload": {
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+						d.ServiceBindingSpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+							d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+								d.APIVersion("apps/v1")
+								d.Kind("Deployment")
+								d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+									d.AddMatchLabel("app", "my-workload")
+								})
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("app", "my-workload")
+						}).
+						DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"clusterQueue": workqueue.New(),
+				"expectedClusterRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Name: bindingName}},
+				},
+			},
+		},
+		"ignore service binding selecting other labels": {
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.Selector(&metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "my-database"},
+							})
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue":            workqueue.New(),
+				"expectedRequests": []reconcile.Request{},
+			},
+		},
+		"enqueue tracked cluster service binding": {
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"clusterQueue": workqueue.New(),
+				"expectedClusterRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Name: bindingName}},
+				},
+			},
+			Prepare: func(t *testing.T, c reconcilers.Config, wtc *rtesting.AdmissionWebhookTestCase) error {
+				ctx := context.TODO()
+				c.Tracker.TrackChild(ctx, clusterServiceBinding.DieReleasePtr(), workload.DieReleasePtr(), c.Scheme())
+				return nil
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload, clusterServiceBinding, scheme),
+			},
+		},
 	}
 	wts.Run(t, scheme, func(t *testing.T, wtc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
 		if wtc.Metadata == nil {
 			wtc.Metadata = map[string]interface{}{}
 		}
 		wtc.CleanUp = func(t *testing.T, wtc *rtesting.AdmissionWebhookTestCase) error {
-			queue, ok := wtc.Metadata["queue"].(workqueue.Interface)
-			if !ok {
-				return nil
-			}
-			actualRequests := []reconcile.Request{}
-			for len(actualRequests) < queue.Len() {
-				request, _ := queue.Get()
-				actualRequests = append(actualRequests, request.(reconcile.Request))
-			}
-			expectedRequests := wtc.Metadata["expectedRequests"].([]reconcile.Request)
-			if diff := cmp.Diff(expectedRequests, actualRequests); diff != "" {
-				t.Errorf("enqueued request (-expected, +actual): %s", diff)
+			for queueKey, expectedKey := range map[string]string{"queue": "expectedRequests", "clusterQueue": "expectedClusterRequests"} {
+				queue, ok := wtc.Metadata[queueKey].(workqueue.Interface)
+				if !ok {
+					continue
+				}
+				actualRequests := []reconcile.Request{}
+				for len(actualRequests) < queue.Len() {
+					request, _ := queue.Get()
+					actualRequests = append(actualRequests, request.(reconcile.Request))
+				}
+				expectedRequests := wtc.Metadata[expectedKey].([]reconcile.Request)
+				if diff := cmp.Diff(expectedRequests, actualRequests); diff != "" {
+					t.Errorf("enqueued request for %s (-expected, +actual): %s", queueKey, diff)
+				}
 			}
 			return nil
 		}
@@ -732,7 +1341,11 @@ func TestTriggerWebhook(t *testing.T) {
 		ctrl := &mockController{
 			Queue: queue,
 		}
-		return controllers.TriggerWebhook(c, ctrl).Build()
+		clusterQueue, _ := wtc.Metadata["clusterQueue"].(workqueue.Interface)
+		clusterCtrl := &mockController{
+			Queue: clusterQueue,
+		}
+		return controllers.TriggerWebhook(c, ctrl, c, clusterCtrl).Build()
 	})
 }
 
@@ -761,6 +1374,26 @@ func TestLoadServiceBindings(t *testing.T) {
 			})
 		})
 
+	clusterServiceBinding := dieservicebindingv1beta1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("example/v1")
+					d.Kind("MyService")
+					d.Name("my-service")
+					d.Namespace("my-namespace")
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("batch/v1")
+					d.Kind("Job")
+					d.Name("my-job")
+				})
+			})
+		})
+
 	rts := rtesting.SubReconcilerTestSuite{{
 		Name:     "list all servicebindings",
 		Resource: webhook,
@@ -772,6 +1405,19 @@ func TestLoadServiceBindings(t *testing.T) {
 				serviceBinding.DieRelease(),
 			},
 		},
+	}, {
+		Name:     "list cluster servicebindings",
+		Resource: webhook,
+		GivenObjects: []client.Object{
+			serviceBinding,
+			clusterServiceBinding,
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.DieRelease(),
+				*clusterServiceBinding.DieReleasePtr().ServiceBinding(""),
+			},
+		},
 	}, {
 		Name:     "error listing all servicebindings",
 		Resource: webhook,
@@ -875,6 +1521,8 @@ func TestTriggerGVKs(t *testing.T) {
 				d.Kind("Deployment")
 				d.Name("my-workload")
 			})
+			d.Type("mysql")
+			d.Provider("bitnami")
 		})
 
 	rts := rtesting.SubReconcilerTestSuite{{
@@ -908,7 +1556,43 @@ func TestTriggerGVKs(t *testing.T) {
 			},
 		},
 	}, {
-		Name:     "ignore direct binding",
+		Name:     "collect gvks for each service",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Service(servicebindingv1beta1.ServiceBindingServiceReference{})
+						d.Type("")
+						d.Provider("")
+						d.ServicesDie("db", func(d *dieservicebindingv1beta1.ServiceBindingServiceEntryDie) {
+							d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+								d.APIVersion("example/v1")
+								d.Kind("MyService")
+								d.Name("my-service")
+							})
+							d.Type("mysql")
+							d.Provider("bitnami")
+						})
+						d.ServicesDie("cache", func(d *dieservicebindingv1beta1.ServiceBindingServiceEntryDie) {
+							d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+								d.APIVersion("v1")
+								d.Kind("Secret")
+								d.Name("my-cache")
+							})
+						})
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "example", Version: "v1", Kind: "MyService"},
+				{Group: "", Version: "v1", Kind: "Secret"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for direct binding",
 		Resource: webhook,
 		GivenStashedValues: map[reconcilers.StashKey]interface{}{
 			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
@@ -923,11 +1607,189 @@ func TestTriggerGVKs(t *testing.T) {
 			},
 		},
 		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{},
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for a direct binding selected by labels",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("v1")
+							d.Kind("Secret")
+							d.Name("")
+							d.Selector(&metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "my-database"},
+							})
+						})
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for env from",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.EnvFromDie(func(d *dieservicebindingv1beta1.EnvFromMappingDie) {})
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for binding type from the secret",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Type("")
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for rollout on rotation",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.RolloutOnRotation(true)
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for binding type profile required keys",
+		Resource: webhook,
+		GivenObjects: []client.Object{
+			dieservicebindingv1beta1.ClusterBindingTypeProfileBlank.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Name("postgresql")
+				}).
+				SpecDie(func(d *dieservicebindingv1beta1.ClusterBindingTypeProfileSpecDie) {
+					d.RequiredKeys("host")
+				}),
+		},
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Type("postgresql")
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for service in another namespace",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.Namespace("data")
+						})
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "collect secret gvk for synthesized binding secret",
+		Resource: webhook,
+		GivenObjects: []client.Object{
+			dieservicebindingv1beta1.ClusterServiceResourceMappingBlank.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Name("myservices.example")
+				}).
+				SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingSpecDie) {
+					d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingTemplateDie) {
+						d.SecretDie(func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingSecretDie) {
+							d.EntryDie("password", func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingEntryDie) {
+								d.SecretKeyRefDie(func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingSecretKeyRefDie) {
+									d.Name(".spec.passwordSecretRef.name")
+									d.Key("password")
+								})
+							})
+						})
+					})
+				}),
+		},
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Secret"},
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
+		},
+	}, {
+		Name:     "ignore binding type without a profile",
+		Resource: webhook,
+		GivenStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Type("redis")
+					}).
+					DieRelease(),
+			},
+		},
+		ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+				{Group: "example", Version: "v1", Kind: "MyService"},
+			},
 		},
 	}}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyService"}, meta.RESTScopeNamespace)
 		return controllers.TriggerGVKs()
 	})
 }
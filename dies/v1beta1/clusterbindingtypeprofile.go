@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ClusterBindingTypeProfile
+
+// +die
+type _ = servicebindingv1beta1.ClusterBindingTypeProfileSpec
+
+func (d *ClusterBindingTypeProfileSpecDie) EnvDie(key string, fn func(d *EnvMappingDie)) *ClusterBindingTypeProfileSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterBindingTypeProfileSpec) {
+		for i := range r.Env {
+			if key == r.Env[i].Key {
+				d := EnvMappingBlank.DieImmutable(false).DieFeed(r.Env[i])
+				fn(d)
+				r.Env[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := EnvMappingBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.EnvMapping{Key: key})
+		fn(d)
+		r.Env = append(r.Env, d.DieRelease())
+	})
+}
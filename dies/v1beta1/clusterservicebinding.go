@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	diemetav1 "dies.dev/apis/meta/v1"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ClusterServiceBinding
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceBindingSpec
+
+func (d *ClusterServiceBindingSpecDie) ServiceBindingSpecDie(fn func(d *ServiceBindingSpecDie)) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceBindingSpec) {
+		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.ServiceBindingSpec)
+		fn(d)
+		r.ServiceBindingSpec = d.DieRelease()
+	})
+}
+
+func (d *ClusterServiceBindingSpecDie) NamespaceSelectorDie(fn func(d *diemetav1.LabelSelectorDie)) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceBindingSpec) {
+		d := diemetav1.LabelSelectorBlank.DieImmutable(false).DieFeed(r.NamespaceSelector)
+		fn(d)
+		r.NamespaceSelector = d.DieRelease()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceBindingStatus
+
+func (d *ClusterServiceBindingStatusDie) ServiceBindingStatusDie(fn func(d *ServiceBindingStatusDie)) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceBindingStatus) {
+		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.ServiceBindingStatus)
+		fn(d)
+		r.ServiceBindingStatus = d.DieRelease()
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ClusterServiceResourceMapping
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingSpec
+
+func (d *ClusterServiceResourceMappingSpecDie) VersionsDie(version string, fn func(d *ClusterServiceResourceMappingTemplateDie)) *ClusterServiceResourceMappingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingSpec) {
+		for i := range r.Versions {
+			if version == r.Versions[i].Version {
+				d := ClusterServiceResourceMappingTemplateBlank.DieImmutable(false).DieFeed(r.Versions[i])
+				fn(d)
+				r.Versions[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ClusterServiceResourceMappingTemplateBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ClusterServiceResourceMappingTemplate{Version: version})
+		fn(d)
+		r.Versions = append(r.Versions, d.DieRelease())
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingTemplate
+
+func (d *ClusterServiceResourceMappingTemplateDie) SecretDie(fn func(d *ClusterServiceResourceMappingSecretDie)) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingTemplate) {
+		d := ClusterServiceResourceMappingSecretBlank.DieImmutable(false).DieFeedPtr(r.Secret)
+		fn(d)
+		r.Secret = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingSecret
+
+func (d *ClusterServiceResourceMappingSecretDie) EntryDie(key string, fn func(d *ClusterServiceResourceMappingEntryDie)) *ClusterServiceResourceMappingSecretDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingSecret) {
+		for i := range r.Entries {
+			if key == r.Entries[i].Key {
+				d := ClusterServiceResourceMappingEntryBlank.DieImmutable(false).DieFeed(r.Entries[i])
+				fn(d)
+				r.Entries[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ClusterServiceResourceMappingEntryBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ClusterServiceResourceMappingEntry{Key: key})
+		fn(d)
+		r.Entries = append(r.Entries, d.DieRelease())
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingEntry
+
+func (d *ClusterServiceResourceMappingEntryDie) SecretKeyRefDie(fn func(d *ClusterServiceResourceMappingSecretKeyRefDie)) *ClusterServiceResourceMappingEntryDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingEntry) {
+		d := ClusterServiceResourceMappingSecretKeyRefBlank.DieImmutable(false).DieFeedPtr(r.SecretKeyRef)
+		fn(d)
+		r.SecretKeyRef = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingSecretKeyRef
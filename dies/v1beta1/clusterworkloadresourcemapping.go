@@ -55,5 +55,26 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) ContainersDie(containers ...
 	})
 }
 
+func (d *ClusterWorkloadResourceMappingTemplateDie) TemplatesDie(templates ...*ClusterWorkloadResourceMappingPodTemplateDie) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Templates = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate, len(templates))
+		for i := range templates {
+			r.Templates[i] = templates[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate
+
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) ContainersDie(containers ...*ClusterWorkloadResourceMappingContainerDie) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Containers = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingContainer, len(containers))
+		for i := range containers {
+			r.Containers[i] = containers[i].DieRelease()
+		}
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingContainer
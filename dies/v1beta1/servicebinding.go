@@ -45,6 +45,23 @@ func (d *ServiceBindingSpecDie) ServiceDie(fn func(d *ServiceBindingServiceRefer
 	})
 }
 
+func (d *ServiceBindingSpecDie) ServicesDie(name string, fn func(d *ServiceBindingServiceEntryDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		for i := range r.Services {
+			if name == r.Services[i].Name {
+				d := ServiceBindingServiceEntryBlank.DieImmutable(false).DieFeed(r.Services[i])
+				fn(d)
+				r.Services[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ServiceBindingServiceEntryBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ServiceBindingServiceEntry{Name: name})
+		fn(d)
+		r.Services = append(r.Services, d.DieRelease())
+	})
+}
+
 func (d *ServiceBindingSpecDie) EnvDie(key string, fn func(d *EnvMappingDie)) *ServiceBindingSpecDie {
 	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
 		for i := range r.Env {
@@ -62,6 +79,39 @@ func (d *ServiceBindingSpecDie) EnvDie(key string, fn func(d *EnvMappingDie)) *S
 	})
 }
 
+func (d *ServiceBindingSpecDie) EnvFromDie(fn func(d *EnvFromMappingDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		d := EnvFromMappingBlank.DieImmutable(false).DieFeedPtr(r.EnvFrom)
+		fn(d)
+		r.EnvFrom = d.DieReleasePtr()
+	})
+}
+
+func (d *ServiceBindingSpecDie) FileDie(key string, fn func(d *FileMappingDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		for i := range r.Files {
+			if key == r.Files[i].Key {
+				d := FileMappingBlank.DieImmutable(false).DieFeed(r.Files[i])
+				fn(d)
+				r.Files[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := FileMappingBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.FileMapping{Key: key})
+		fn(d)
+		r.Files = append(r.Files, d.DieRelease())
+	})
+}
+
+func (d *ServiceBindingSpecDie) VolumeDie(fn func(d *VolumeOptionsDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		d := VolumeOptionsBlank.DieImmutable(false).DieFeedPtr(r.Volume)
+		fn(d)
+		r.Volume = d.DieReleasePtr()
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.ServiceBindingWorkloadReference
 
@@ -76,9 +126,29 @@ func (d *ServiceBindingWorkloadReferenceDie) SelectorDie(fn func(d *diemetav1.La
 // +die
 type _ = servicebindingv1beta1.ServiceBindingServiceReference
 
+// +die
+type _ = servicebindingv1beta1.ServiceBindingServiceEntry
+
+func (d *ServiceBindingServiceEntryDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingServiceEntry) {
+		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeed(r.Service)
+		fn(d)
+		r.Service = d.DieRelease()
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.EnvMapping
 
+// +die
+type _ = servicebindingv1beta1.EnvFromMapping
+
+// +die
+type _ = servicebindingv1beta1.FileMapping
+
+// +die
+type _ = servicebindingv1beta1.VolumeOptions
+
 // +die
 type _ = servicebindingv1beta1.ServiceBindingStatus
 
@@ -103,5 +173,42 @@ func (d *ServiceBindingStatusDie) BindingDie(fn func(d *ServiceBindingSecretRefe
 	})
 }
 
+func (d *ServiceBindingStatusDie) ServicesDie(name string, fn func(d *ServiceBindingServiceStatusDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
+		for i := range r.Services {
+			if name == r.Services[i].Name {
+				d := ServiceBindingServiceStatusBlank.DieImmutable(false).DieFeed(r.Services[i])
+				fn(d)
+				r.Services[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ServiceBindingServiceStatusBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ServiceBindingServiceStatus{Name: name})
+		fn(d)
+		r.Services = append(r.Services, d.DieRelease())
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingServiceStatus
+
+func (d *ServiceBindingServiceStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionDie) *ServiceBindingServiceStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingServiceStatus) {
+		r.Conditions = make([]metav1.Condition, len(conditions))
+		for i := range conditions {
+			r.Conditions[i] = conditions[i].DieRelease()
+		}
+	})
+}
+
+func (d *ServiceBindingServiceStatusDie) BindingDie(fn func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingServiceStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingServiceStatus) {
+		d := ServiceBindingSecretReferenceBlank.DieImmutable(false).DieFeedPtr(r.Binding)
+		fn(d)
+		r.Binding = d.DieReleasePtr()
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.ServiceBindingSecretReference
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2021 Original Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ServiceBindingGrant
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingGrantSpec
+
+func (d *ServiceBindingGrantSpecDie) FromDie(namespace string, fn func(d *ServiceBindingGrantFromDie)) *ServiceBindingGrantSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingGrantSpec) {
+		for i := range r.From {
+			if namespace == r.From[i].Namespace {
+				d := ServiceBindingGrantFromBlank.DieImmutable(false).DieFeed(r.From[i])
+				fn(d)
+				r.From[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ServiceBindingGrantFromBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ServiceBindingGrantFrom{Namespace: namespace})
+		fn(d)
+		r.From = append(r.From, d.DieRelease())
+	})
+}
+
+func (d *ServiceBindingGrantSpecDie) ToDie(fn func(d *ServiceBindingGrantToDie)) *ServiceBindingGrantSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingGrantSpec) {
+		d := ServiceBindingGrantToBlank.DieImmutable(false)
+		fn(d)
+		r.To = append(r.To, d.DieRelease())
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingGrantFrom
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingGrantTo
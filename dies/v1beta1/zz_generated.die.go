@@ -34,16 +34,16 @@ import (
 	apisv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
 )
 
-var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMapping{})
+var ClusterBindingTypeProfileBlank = (&ClusterBindingTypeProfileDie{}).DieFeed(apisv1beta1.ClusterBindingTypeProfile{})
 
-type ClusterWorkloadResourceMappingDie struct {
+type ClusterBindingTypeProfileDie struct {
 	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMapping
+	r       apisv1beta1.ClusterBindingTypeProfile
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DieImmutable(immutable bool) *ClusterBindingTypeProfileDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -53,13 +53,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *Cluste
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DieFeed(r apisv1beta1.ClusterBindingTypeProfile) *ClusterBindingTypeProfileDie {
 	if d.mutable {
 		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingDie{
+	return &ClusterBindingTypeProfileDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -67,23 +67,23 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloa
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DieFeedPtr(r *apisv1beta1.ClusterBindingTypeProfile) *ClusterBindingTypeProfileDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMapping{}
+		r = &apisv1beta1.ClusterBindingTypeProfile{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterBindingTypeProfileDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMapping{}
+	r := apisv1beta1.ClusterBindingTypeProfile{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMapping {
+func (d *ClusterBindingTypeProfileDie) DieRelease() apisv1beta1.ClusterBindingTypeProfile {
 	if d.mutable {
 		return d.r
 	}
@@ -91,13 +91,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWork
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMapping {
+func (d *ClusterBindingTypeProfileDie) DieReleasePtr() *apisv1beta1.ClusterBindingTypeProfile {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+func (d *ClusterBindingTypeProfileDie) DieReleaseUnstructured() runtime.Unstructured {
 	r := d.DieReleasePtr()
 	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
 	return &unstructured.Unstructured{
@@ -106,7 +106,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Uns
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterBindingTypeProfileDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -115,67 +115,67 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DieStamp(fn func(r *apisv1beta1.ClusterBindingTypeProfile)) *ClusterBindingTypeProfileDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
+func (d *ClusterBindingTypeProfileDie) DeepCopy() *ClusterBindingTypeProfileDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingDie{
+	return &ClusterBindingTypeProfileDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
 	}
 }
 
-var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
+var _ runtime.Object = (*ClusterBindingTypeProfileDie)(nil)
 
-func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+func (d *ClusterBindingTypeProfileDie) DeepCopyObject() runtime.Object {
 	return d.r.DeepCopy()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+func (d *ClusterBindingTypeProfileDie) GetObjectKind() schema.ObjectKind {
 	r := d.DieRelease()
 	return r.GetObjectKind()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+func (d *ClusterBindingTypeProfileDie) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.r)
 }
 
-func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
-	if d == ClusterWorkloadResourceMappingBlank {
+func (d *ClusterBindingTypeProfileDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterBindingTypeProfileBlank {
 		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
 	}
 	if !d.mutable {
 		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
 	}
-	r := &apisv1beta1.ClusterWorkloadResourceMapping{}
+	r := &apisv1beta1.ClusterBindingTypeProfile{}
 	err := json.Unmarshal(b, r)
 	*d = *d.DieFeed(*r)
 	return err
 }
 
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *ClusterBindingTypeProfileDie) APIVersion(v string) *ClusterBindingTypeProfileDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfile) {
 		r.APIVersion = v
 	})
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *ClusterBindingTypeProfileDie) Kind(v string) *ClusterBindingTypeProfileDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfile) {
 		r.Kind = v
 	})
 }
 
 // MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *ClusterBindingTypeProfileDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterBindingTypeProfileDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfile) {
 		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
 		r.ObjectMeta = d.DieRelease()
@@ -183,29 +183,29 @@ func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMeta
 }
 
 // SpecDie stamps the resource's spec field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
-		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+func (d *ClusterBindingTypeProfileDie) SpecDie(fn func(d *ClusterBindingTypeProfileSpecDie)) *ClusterBindingTypeProfileDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfile) {
+		d := ClusterBindingTypeProfileSpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
 		r.Spec = d.DieRelease()
 	})
 }
 
-func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *ClusterBindingTypeProfileDie) Spec(v apisv1beta1.ClusterBindingTypeProfileSpec) *ClusterBindingTypeProfileDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfile) {
 		r.Spec = v
 	})
 }
 
-var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingSpec{})
+var ClusterBindingTypeProfileSpecBlank = (&ClusterBindingTypeProfileSpecDie{}).DieFeed(apisv1beta1.ClusterBindingTypeProfileSpec{})
 
-type ClusterWorkloadResourceMappingSpecDie struct {
+type ClusterBindingTypeProfileSpecDie struct {
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingSpec
+	r       apisv1beta1.ClusterBindingTypeProfileSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DieImmutable(immutable bool) *ClusterBindingTypeProfileSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -215,35 +215,35 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *Cl
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DieFeed(r apisv1beta1.ClusterBindingTypeProfileSpec) *ClusterBindingTypeProfileSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &ClusterBindingTypeProfileSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DieFeedPtr(r *apisv1beta1.ClusterBindingTypeProfileSpec) *ClusterBindingTypeProfileSpecDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+		r = &apisv1beta1.ClusterBindingTypeProfileSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterBindingTypeProfileSpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	r := apisv1beta1.ClusterBindingTypeProfileSpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterBindingTypeProfileSpecDie) DieRelease() apisv1beta1.ClusterBindingTypeProfileSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -251,13 +251,13 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.Cluster
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterBindingTypeProfileSpecDie) DieReleasePtr() *apisv1beta1.ClusterBindingTypeProfileSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterBindingTypeProfileSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -266,37 +266,45 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterBindingTypeProfileSpec)) *ClusterBindingTypeProfileSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterBindingTypeProfileSpecDie) DeepCopy() *ClusterBindingTypeProfileSpecDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &ClusterBindingTypeProfileSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Versions is the collection of versions for a given resource, with mappings.
-func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) {
-		r.Versions = v
+// Env is the collection of default mappings from Secret entries to environment variables for service bindings of the type. A mapping defined by the ServiceBinding for the same environment variable takes precedence.
+func (d *ClusterBindingTypeProfileSpecDie) Env(v ...apisv1beta1.EnvMapping) *ClusterBindingTypeProfileSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfileSpec) {
+		r.Env = v
 	})
 }
 
-var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingTemplate{})
+// RequiredKeys are the entries the binding Secret must contain for service bindings of the type
+func (d *ClusterBindingTypeProfileSpecDie) RequiredKeys(v ...string) *ClusterBindingTypeProfileSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterBindingTypeProfileSpec) {
+		r.RequiredKeys = v
+	})
+}
 
-type ClusterWorkloadResourceMappingTemplateDie struct {
+var ClusterServiceBindingBlank = (&ClusterServiceBindingDie{}).DieFeed(apisv1beta1.ClusterServiceBinding{})
+
+type ClusterServiceBindingDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingTemplate
+	r       apisv1beta1.ClusterServiceBinding
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DieImmutable(immutable bool) *ClusterServiceBindingDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -306,35 +314,37 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool)
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DieFeed(r apisv1beta1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingTemplateDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ClusterServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DieFeedPtr(r *apisv1beta1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+		r = &apisv1beta1.ClusterServiceBinding{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	r := apisv1beta1.ClusterServiceBinding{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingDie) DieRelease() apisv1beta1.ClusterServiceBinding {
 	if d.mutable {
 		return d.r
 	}
@@ -342,13 +352,22 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.Clu
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingDie) DieReleasePtr() *apisv1beta1.ClusterServiceBinding {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterServiceBindingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -357,58 +376,112 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() run
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceBinding)) *ClusterServiceBindingDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingDie) DeepCopy() *ClusterServiceBindingDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingTemplateDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ClusterServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
-// Version is the version of the workload resource that this mapping is for.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Version = v
+var _ runtime.Object = (*ClusterServiceBindingDie)(nil)
+
+func (d *ClusterServiceBindingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterServiceBindingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterServiceBindingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterServiceBindingDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterServiceBindingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ClusterServiceBinding{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterServiceBindingDie) APIVersion(v string) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		r.APIVersion = v
 	})
 }
 
-// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These annotations must end up in the resulting Pod, and are generally not the workload resource's annotations. Defaults to `.spec.template.metadata.annotations`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Annotations = v
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterServiceBindingDie) Kind(v string) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		r.Kind = v
 	})
 }
 
-// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to mappings appropriate for a PodSpecable resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Containers = v
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
 	})
 }
 
-// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to `.spec.template.spec.volumes`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Volumes = v
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ClusterServiceBindingDie) SpecDie(fn func(d *ClusterServiceBindingSpecDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		d := ClusterServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
 	})
 }
 
-var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingContainer{})
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ClusterServiceBindingDie) StatusDie(fn func(d *ClusterServiceBindingStatusDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		d := ClusterServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
 
-type ClusterWorkloadResourceMappingContainerDie struct {
+func (d *ClusterServiceBindingDie) Spec(v apisv1beta1.ClusterServiceBindingSpec) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterServiceBindingDie) Status(v apisv1beta1.ClusterServiceBindingStatus) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBinding) {
+		r.Status = v
+	})
+}
+
+var ClusterServiceBindingSpecBlank = (&ClusterServiceBindingSpecDie{}).DieFeed(apisv1beta1.ClusterServiceBindingSpec{})
+
+type ClusterServiceBindingSpecDie struct {
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingContainer
+	r       apisv1beta1.ClusterServiceBindingSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DieImmutable(immutable bool) *ClusterServiceBindingSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -418,35 +491,35 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DieFeed(r apisv1beta1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &ClusterServiceBindingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+		r = &apisv1beta1.ClusterServiceBindingSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingSpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	r := apisv1beta1.ClusterServiceBindingSpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingSpecDie) DieRelease() apisv1beta1.ClusterServiceBindingSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -454,13 +527,13 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.Cl
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingSpecDie) DieReleasePtr() *apisv1beta1.ClusterServiceBindingSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -469,59 +542,37 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() ru
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceBindingSpec)) *ClusterServiceBindingSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingSpecDie) DeepCopy() *ClusterServiceBindingSpecDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &ClusterServiceBindingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
-func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Path = v
-	})
-}
-
-// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource fragment. If not defined, container name filtering is ignored.
-func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Name = v
-	})
-}
-
-// Env is a Restricted JSONPath that references the slice of environment variables for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.envs`.
-func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Env = v
-	})
-}
-
-// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.volumeMounts`.
-func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.VolumeMounts = v
+// NamespaceSelector selects the namespaces the binding is projected into. An empty selector selects every namespace.
+func (d *ClusterServiceBindingSpecDie) NamespaceSelector(v metav1.LabelSelector) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBindingSpec) {
+		r.NamespaceSelector = v
 	})
 }
 
-var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1beta1.ServiceBinding{})
+var ClusterServiceBindingStatusBlank = (&ClusterServiceBindingStatusDie{}).DieFeed(apisv1beta1.ClusterServiceBindingStatus{})
 
-type ServiceBindingDie struct {
-	v1.FrozenObjectMeta
+type ClusterServiceBindingStatusDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBinding
+	r       apisv1beta1.ClusterServiceBindingStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DieImmutable(immutable bool) *ClusterServiceBindingStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -531,37 +582,35 @@ func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingDie) DieFeed(r apisv1beta1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DieFeed(r apisv1beta1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
+	return &ClusterServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingDie) DieFeedPtr(r *apisv1beta1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBinding{}
+		r = &apisv1beta1.ClusterServiceBindingStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingStatusDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBinding{}
+	r := apisv1beta1.ClusterServiceBindingStatus{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
+func (d *ClusterServiceBindingStatusDie) DieRelease() apisv1beta1.ClusterServiceBindingStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -569,22 +618,13 @@ func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingDie) DieReleasePtr() *apisv1beta1.ServiceBinding {
+func (d *ClusterServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.ClusterServiceBindingStatus {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
-func (d *ServiceBindingDie) DieReleaseUnstructured() runtime.Unstructured {
-	r := d.DieReleasePtr()
-	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -593,67 +633,170 @@ func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1beta1.ServiceBinding)) *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceBindingStatus)) *ClusterServiceBindingStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+func (d *ClusterServiceBindingStatusDie) DeepCopy() *ClusterServiceBindingStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
+	return &ClusterServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
 	}
 }
 
-var _ runtime.Object = (*ServiceBindingDie)(nil)
-
-func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
+// Namespaces are the namespaces the binding is projected into. Workloads in namespaces that are no longer selected are unprojected.
+func (d *ClusterServiceBindingStatusDie) Namespaces(v ...string) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceBindingStatus) {
+		r.Namespaces = v
+	})
 }
 
-func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
+var ClusterServiceResourceMappingBlank = (&ClusterServiceResourceMappingDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMapping{})
 
-func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
+type ClusterServiceResourceMappingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMapping
 }
 
-func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
-	if d == ServiceBindingBlank {
-		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingDie {
+	if d.mutable == !immutable {
+		return d
 	}
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingDie) DieFeed(r apisv1beta1.ClusterServiceResourceMapping) *ClusterServiceResourceMappingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
 	}
-	r := &apisv1beta1.ServiceBinding{}
+	return &ClusterServiceResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMapping) *ClusterServiceResourceMappingDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingDie) DieRelease() apisv1beta1.ClusterServiceResourceMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterServiceResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMapping)) *ClusterServiceResourceMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingDie) DeepCopy() *ClusterServiceResourceMappingDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ClusterServiceResourceMappingDie)(nil)
+
+func (d *ClusterServiceResourceMappingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterServiceResourceMappingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterServiceResourceMappingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterServiceResourceMappingDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterServiceResourceMappingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ClusterServiceResourceMapping{}
 	err := json.Unmarshal(b, r)
 	*d = *d.DieFeed(*r)
 	return err
 }
 
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+func (d *ClusterServiceResourceMappingDie) APIVersion(v string) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
 		r.APIVersion = v
 	})
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+func (d *ClusterServiceResourceMappingDie) Kind(v string) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
 		r.Kind = v
 	})
 }
 
 // MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+func (d *ClusterServiceResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
 		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
 		r.ObjectMeta = d.DieRelease()
@@ -661,44 +804,120 @@ func (d *ServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBi
 }
 
 // SpecDie stamps the resource's spec field with a mutable die.
-func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+func (d *ClusterServiceResourceMappingDie) SpecDie(fn func(d *ClusterServiceResourceMappingSpecDie)) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		d := ClusterServiceResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
 		r.Spec = d.DieRelease()
 	})
 }
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
+func (d *ClusterServiceResourceMappingDie) Spec(v apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		r.Spec = v
 	})
 }
 
-func (d *ServiceBindingDie) Spec(v apisv1beta1.ServiceBindingSpec) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.Spec = v
-	})
+var ClusterServiceResourceMappingSpecBlank = (&ClusterServiceResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingSpec{})
+
+type ClusterServiceResourceMappingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingSpec
 }
 
-func (d *ServiceBindingDie) Status(v apisv1beta1.ServiceBindingStatus) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.Status = v
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingSpec)) *ClusterServiceResourceMappingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingSpecDie) DeepCopy() *ClusterServiceResourceMappingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterServiceResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingSpec) {
+		r.Versions = v
 	})
 }
 
-var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1beta1.ServiceBindingSpec{})
+var ClusterServiceResourceMappingTemplateBlank = (&ClusterServiceResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingTemplate{})
 
-type ServiceBindingSpecDie struct {
+type ClusterServiceResourceMappingTemplateDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingSpec
+	r       apisv1beta1.ClusterServiceResourceMappingTemplate
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingTemplateDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -708,35 +927,35 @@ func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpec
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSpecDie) DieFeed(r apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingTemplateDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSpecDie{
+	return &ClusterServiceResourceMappingTemplateDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingTemplateDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingSpec{}
+		r = &apisv1beta1.ClusterServiceResourceMappingTemplate{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingTemplateDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingSpec{}
+	r := apisv1beta1.ClusterServiceResourceMappingTemplate{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
+func (d *ClusterServiceResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingTemplate {
 	if d.mutable {
 		return d.r
 	}
@@ -744,13 +963,13 @@ func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingSpec {
+func (d *ClusterServiceResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingTemplate {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -759,72 +978,2310 @@ func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingTemplate)) *ClusterServiceResourceMappingTemplateDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+func (d *ClusterServiceResourceMappingTemplateDie) DeepCopy() *ClusterServiceResourceMappingTemplateDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSpecDie{
+	return &ClusterServiceResourceMappingTemplateDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
-func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Name = v
+// Version is the version of the service resource that this mapping is for.
+func (d *ClusterServiceResourceMappingTemplateDie) Version(v string) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.Version = v
 	})
 }
 
-// Type is the type of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Type = v
+// SecretName is a JSONPath that references the name of the binding Secret within the service resource. The Secret must be in the same namespace as the service. Mutually exclusive with Secret. Defaults to `.status.binding.name` when Secret is not set.
+func (d *ClusterServiceResourceMappingTemplateDie) SecretName(v string) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.SecretName = v
+	})
+}
+
+// Secret synthesises a binding Secret from fields of the service resource and entries of other Secrets the service references, for service resources that do not expose a binding Secret. Mutually exclusive with SecretName.
+func (d *ClusterServiceResourceMappingTemplateDie) Secret(v *apisv1beta1.ClusterServiceResourceMappingSecret) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.Secret = v
 	})
 }
 
-// Provider is the provider of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Provider = v
-	})
+var ClusterServiceResourceMappingSecretBlank = (&ClusterServiceResourceMappingSecretDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingSecret{})
+
+type ClusterServiceResourceMappingSecretDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingSecret
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingSecretDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingSecretDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingSecretDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingSecret) *ClusterServiceResourceMappingSecretDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingSecretDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingSecretDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingSecret) *ClusterServiceResourceMappingSecretDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingSecret{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSecretDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingSecretDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingSecret{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingSecretDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingSecret {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingSecretDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingSecret {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSecretDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingSecretDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingSecret)) *ClusterServiceResourceMappingSecretDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingSecretDie) DeepCopy() *ClusterServiceResourceMappingSecretDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingSecretDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Entries is the collection of entries in the binding Secret.
+func (d *ClusterServiceResourceMappingSecretDie) Entries(v ...apisv1beta1.ClusterServiceResourceMappingEntry) *ClusterServiceResourceMappingSecretDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingSecret) {
+		r.Entries = v
+	})
+}
+
+var ClusterServiceResourceMappingEntryBlank = (&ClusterServiceResourceMappingEntryDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingEntry{})
+
+type ClusterServiceResourceMappingEntryDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingEntry
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingEntryDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingEntryDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingEntryDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingEntry) *ClusterServiceResourceMappingEntryDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingEntryDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingEntryDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingEntry) *ClusterServiceResourceMappingEntryDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingEntry{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingEntryDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingEntryDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingEntry{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingEntryDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingEntry {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingEntryDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingEntry {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingEntryDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingEntryDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingEntry)) *ClusterServiceResourceMappingEntryDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingEntryDie) DeepCopy() *ClusterServiceResourceMappingEntryDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingEntryDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Key is the key of the entry in the binding Secret.
+func (d *ClusterServiceResourceMappingEntryDie) Key(v string) *ClusterServiceResourceMappingEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingEntry) {
+		r.Key = v
+	})
+}
+
+// Path is a JSONPath that references the value of the entry within the service resource. Mutually exclusive with SecretKeyRef.
+func (d *ClusterServiceResourceMappingEntryDie) Path(v string) *ClusterServiceResourceMappingEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingEntry) {
+		r.Path = v
+	})
+}
+
+// SecretKeyRef references the value of the entry within another Secret the service resource references. Mutually exclusive with Path.
+func (d *ClusterServiceResourceMappingEntryDie) SecretKeyRef(v *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef) *ClusterServiceResourceMappingEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingEntry) {
+		r.SecretKeyRef = v
+	})
+}
+
+var ClusterServiceResourceMappingSecretKeyRefBlank = (&ClusterServiceResourceMappingSecretKeyRefDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingSecretKeyRef{})
+
+type ClusterServiceResourceMappingSecretKeyRefDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingSecretKeyRef
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingSecretKeyRefDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingSecretKeyRef) *ClusterServiceResourceMappingSecretKeyRefDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingSecretKeyRefDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef) *ClusterServiceResourceMappingSecretKeyRefDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingSecretKeyRef{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingSecretKeyRefDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingSecretKeyRef{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingSecretKeyRef {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef)) *ClusterServiceResourceMappingSecretKeyRefDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) DeepCopy() *ClusterServiceResourceMappingSecretKeyRefDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingSecretKeyRefDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is a JSONPath that references the name of the Secret within the service resource.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) Name(v string) *ClusterServiceResourceMappingSecretKeyRefDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef) {
+		r.Name = v
+	})
+}
+
+// Key is the key of the entry within the referenced Secret.
+func (d *ClusterServiceResourceMappingSecretKeyRefDie) Key(v string) *ClusterServiceResourceMappingSecretKeyRefDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingSecretKeyRef) {
+		r.Key = v
+	})
+}
+
+var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMapping{})
+
+type ClusterWorkloadResourceMappingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
+
+func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterWorkloadResourceMappingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ClusterWorkloadResourceMapping{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.Spec = v
+	})
+}
+
+var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingSpec{})
+
+type ClusterWorkloadResourceMappingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) {
+		r.Versions = v
+	})
+}
+
+var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingTemplate{})
+
+type ClusterWorkloadResourceMappingTemplateDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Version is the version of the workload resource that this mapping is for.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Version = v
+	})
+}
+
+// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These annotations must end up in the resulting Pod, and are generally not the workload resource's annotations. Defaults to `.spec.template.metadata.annotations`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Annotations = v
+	})
+}
+
+// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to mappings appropriate for a PodSpecable resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Containers = v
+	})
+}
+
+// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to `.spec.template.spec.volumes`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Volumes = v
+	})
+}
+
+// VolumesShape is the JSON structure of the volumes referenced by Volumes. Defaults to `List`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) VolumesShape(v apisv1beta1.ClusterWorkloadResourceMappingShape) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.VolumesShape = v
+	})
+}
+
+// Templates is the collection of mappings to pod template-like fragments for workload resources that contain more than one pod template, like a driver and executors. The service binding is projected into each template independently. When defined, Annotations, Containers, Volumes and VolumesShape must be empty and are not defaulted.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Templates(v ...apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Templates = v
+	})
+}
+
+var ClusterWorkloadResourceMappingPodTemplateBlank = (&ClusterWorkloadResourceMappingPodTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{})
+
+type ClusterWorkloadResourceMappingPodTemplateDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingPodTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingPodTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingPodTemplateDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingPodTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate)) *ClusterWorkloadResourceMappingPodTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingPodTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingPodTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Annotations is a Restricted JSONPath that references the annotations map within the workload resource for this pod template.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Annotations = v
+	})
+}
+
+// Containers is the collection of mappings to container-like fragments of the workload resource for this pod template.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Containers = v
+	})
+}
+
+// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource for this pod template.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Volumes = v
+	})
+}
+
+// VolumesShape is the JSON structure of the volumes referenced by Volumes. Defaults to `List`.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) VolumesShape(v apisv1beta1.ClusterWorkloadResourceMappingShape) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.VolumesShape = v
+	})
+}
+
+var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingContainer{})
+
+type ClusterWorkloadResourceMappingContainerDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingContainer
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingContainer {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingContainer {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
+func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Path = v
+	})
+}
+
+// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource fragment. If not defined, container name filtering is ignored.
+func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Name = v
+	})
+}
+
+// Env is a Restricted JSONPath that references the slice of environment variables for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.envs`.
+func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Env = v
+	})
+}
+
+// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.volumeMounts`.
+func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.VolumeMounts = v
+	})
+}
+
+// EnvShape is the JSON structure of the environment variables referenced by Env. Defaults to `List`.
+func (d *ClusterWorkloadResourceMappingContainerDie) EnvShape(v apisv1beta1.ClusterWorkloadResourceMappingShape) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.EnvShape = v
+	})
+}
+
+// VolumeMountsShape is the JSON structure of the volume mounts referenced by VolumeMounts. Defaults to `List`.
+func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMountsShape(v apisv1beta1.ClusterWorkloadResourceMappingShape) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.VolumeMountsShape = v
+	})
+}
+
+var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1beta1.ServiceBinding{})
+
+type ServiceBindingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ServiceBinding
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingDie) DieFeed(r apisv1beta1.ServiceBinding) *ServiceBindingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingDie) DieFeedPtr(r *apisv1beta1.ServiceBinding) *ServiceBindingDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBinding{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBinding{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingDie) DieReleasePtr() *apisv1beta1.ServiceBinding {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ServiceBindingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1beta1.ServiceBinding)) *ServiceBindingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ServiceBindingDie)(nil)
+
+func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
+	if d == ServiceBindingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ServiceBinding{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ServiceBindingDie) Spec(v apisv1beta1.ServiceBindingSpec) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Spec = v
+	})
+}
+
+func (d *ServiceBindingDie) Status(v apisv1beta1.ServiceBindingStatus) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Status = v
+	})
+}
+
+var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1beta1.ServiceBindingSpec{})
+
+type ServiceBindingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingSpecDie) DieFeed(r apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name. Must not be set with Services.
+func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Name = v
+	})
+}
+
+// Type is the type of the service as projected into the workload container. Must not be set with Services.
+func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Type = v
+	})
+}
+
+// Provider is the provider of the service as projected into the workload container. Must not be set with Services.
+func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Provider = v
+	})
+}
+
+// Workload is a reference to an object
+func (d *ServiceBindingSpecDie) Workload(v apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Workload = v
+	})
+}
+
+// Service is a reference to an object that fulfills the ProvisionedService duck type. Mutually exclusive with Services.
+func (d *ServiceBindingSpecDie) Service(v apisv1beta1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Service = v
+	})
+}
+
+// Services is the collection of services bound to the workload, each with its own name, type, provider and env mappings. The remaining options of the binding apply to every service. Mutually exclusive with Service.
+func (d *ServiceBindingSpecDie) Services(v ...apisv1beta1.ServiceBindingServiceEntry) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Services = v
+	})
+}
+
+// Env is the collection of mappings from Secret entries to environment variables. Must not be set with Services.
+func (d *ServiceBindingSpecDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Env = v
+	})
+}
+
+// EnvFrom projects every Secret entry as an environment variable. Variable names are the prefix and key, upper cased with characters that are not valid in a C identifier replaced by an underscore. Mappings in Env take precedence.
+func (d *ServiceBindingSpecDie) EnvFrom(v *apisv1beta1.EnvFromMapping) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.EnvFrom = v
+	})
+}
+
+// Files is the collection of Secret entries projected into the binding volume. When empty, every entry in the Secret is projected as a file named by its key. Must not be set with Services.
+func (d *ServiceBindingSpecDie) Files(v ...apisv1beta1.FileMapping) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Files = v
+	})
+}
+
+// RolloutOnRotation stamps a hash of the content of the Secret into the workload's pod template, so that the workload is rolled out when the Secret is rotated without being renamed.
+func (d *ServiceBindingSpecDie) RolloutOnRotation(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.RolloutOnRotation = v
+	})
+}
+
+// Volume overrides how the binding volume is projected into the workload
+func (d *ServiceBindingSpecDie) Volume(v *apisv1beta1.VolumeOptions) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Volume = v
+	})
+}
+
+// RequireServiceReady holds back projecting the binding until the service reports a Ready condition that is True. The ServiceAvailable condition of the binding mirrors the status, reason and message of the service's Ready condition until then. A binding that is already projected is left as is. Ignored for a Secret referenced directly.
+func (d *ServiceBindingSpecDie) RequireServiceReady(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.RequireServiceReady = v
+	})
+}
+
+// RecreateUnstartedJobs deletes and recreates, as projected, a Job that cannot be updated as its pod template is immutable, as long as the Job has not started any pods and is not suspended. Otherwise the Job is left as is, the service is projected into a Job when it is created.
+func (d *ServiceBindingSpecDie) RecreateUnstartedJobs(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.RecreateUnstartedJobs = v
+	})
+}
+
+var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingWorkloadReference{})
+
+type ServiceBindingWorkloadReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingWorkloadReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingWorkloadReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingWorkloadReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBindingWorkloadReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingWorkloadReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// API version of the referent.
+func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Name = v
+	})
+}
+
+// Selector is a query that selects the workload or workloads to bind the service to
+func (d *ServiceBindingWorkloadReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Selector = v
+	})
+}
+
+// Containers describes which containers in a Pod should be bound to
+func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Containers = v
+	})
+}
+
+var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingServiceReference{})
+
+type ServiceBindingServiceReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingServiceReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingServiceReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingServiceReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBindingServiceReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// API version of the referent.
+func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent. Mutually exclusive with Selector. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Name = v
+	})
+}
+
+// Selector is a query that selects the service by its labels. Mutually exclusive with Name. When several services are selected, the service with the highest integer `servicebinding.io/service-priority` annotation is bound. A service without the annotation has a priority of 0. Services that tie for the highest priority are not bound.
+func (d *ServiceBindingServiceReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Selector = v
+	})
+}
+
+// Namespace of the referent. Defaults to the namespace of the ServiceBinding. A service in another namespace must be granted to the ServiceBinding by a ServiceBindingGrant in the service's namespace, the binding Secret is replicated into the namespace of the ServiceBinding.
+func (d *ServiceBindingServiceReferenceDie) Namespace(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Namespace = v
+	})
+}
+
+var ServiceBindingServiceEntryBlank = (&ServiceBindingServiceEntryDie{}).DieFeed(apisv1beta1.ServiceBindingServiceEntry{})
+
+type ServiceBindingServiceEntryDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingServiceEntry
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingServiceEntryDie) DieImmutable(immutable bool) *ServiceBindingServiceEntryDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingServiceEntryDie) DieFeed(r apisv1beta1.ServiceBindingServiceEntry) *ServiceBindingServiceEntryDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingServiceEntryDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceEntryDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceEntry) *ServiceBindingServiceEntryDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingServiceEntry{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceEntryDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceEntryDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingServiceEntry{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingServiceEntryDie) DieRelease() apisv1beta1.ServiceBindingServiceEntry {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingServiceEntryDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceEntry {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceEntryDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingServiceEntryDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceEntry)) *ServiceBindingServiceEntryDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingServiceEntryDie) DeepCopy() *ServiceBindingServiceEntryDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingServiceEntryDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is the name of the service as projected into the workload container. Must be unique within the binding.
+func (d *ServiceBindingServiceEntryDie) Name(v string) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceEntry) {
+		r.Name = v
+	})
+}
+
+// Type is the type of the service as projected into the workload container
+func (d *ServiceBindingServiceEntryDie) Type(v string) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceEntry) {
+		r.Type = v
+	})
+}
+
+// Provider is the provider of the service as projected into the workload container
+func (d *ServiceBindingServiceEntryDie) Provider(v string) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceEntry) {
+		r.Provider = v
+	})
+}
+
+// Service is a reference to an object that fulfills the ProvisionedService duck type
+func (d *ServiceBindingServiceEntryDie) Service(v apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceEntry) {
+		r.Service = v
+	})
+}
+
+// Env is the collection of mappings from the service's Secret entries to environment variables
+func (d *ServiceBindingServiceEntryDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingServiceEntryDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceEntry) {
+		r.Env = v
+	})
+}
+
+var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1beta1.EnvMapping{})
+
+type EnvMappingDie struct {
+	mutable bool
+	r       apisv1beta1.EnvMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *EnvMappingDie) DieFeed(r apisv1beta1.EnvMapping) *EnvMappingDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *EnvMappingDie) DieFeedPtr(r *apisv1beta1.EnvMapping) *EnvMappingDie {
+	if r == nil {
+		r = &apisv1beta1.EnvMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.EnvMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *EnvMappingDie) DieReleasePtr() *apisv1beta1.EnvMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *EnvMappingDie) DieStamp(fn func(r *apisv1beta1.EnvMapping)) *EnvMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+	r := *d.r.DeepCopy()
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is the name of the environment variable
+func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
+		r.Name = v
+	})
+}
+
+// Key is the key in the Secret that will be exposed. Mutually exclusive with Template.
+func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
+		r.Key = v
+	})
+}
+
+// Template renders the value of the environment variable from the entries in the Secret using Go text/template syntax, like `postgres://{{ .username }}:{{ .password }}@{{ .host }}:{{ .port }}/{{ .database }}`. The rendered value is stored in a Secret owned by the ServiceBinding. Mutually exclusive with Key.
+func (d *EnvMappingDie) Template(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
+		r.Template = v
+	})
+}
+
+var EnvFromMappingBlank = (&EnvFromMappingDie{}).DieFeed(apisv1beta1.EnvFromMapping{})
+
+type EnvFromMappingDie struct {
+	mutable bool
+	r       apisv1beta1.EnvFromMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *EnvFromMappingDie) DieImmutable(immutable bool) *EnvFromMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *EnvFromMappingDie) DieFeed(r apisv1beta1.EnvFromMapping) *EnvFromMappingDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &EnvFromMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *EnvFromMappingDie) DieFeedPtr(r *apisv1beta1.EnvFromMapping) *EnvFromMappingDie {
+	if r == nil {
+		r = &apisv1beta1.EnvFromMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvFromMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvFromMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.EnvFromMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *EnvFromMappingDie) DieRelease() apisv1beta1.EnvFromMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *EnvFromMappingDie) DieReleasePtr() *apisv1beta1.EnvFromMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvFromMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *EnvFromMappingDie) DieStamp(fn func(r *apisv1beta1.EnvFromMapping)) *EnvFromMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *EnvFromMappingDie) DeepCopy() *EnvFromMappingDie {
+	r := *d.r.DeepCopy()
+	return &EnvFromMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Prefix is prepended to the key of each Secret entry to form the name of the environment variable
+func (d *EnvFromMappingDie) Prefix(v string) *EnvFromMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvFromMapping) {
+		r.Prefix = v
+	})
+}
+
+var FileMappingBlank = (&FileMappingDie{}).DieFeed(apisv1beta1.FileMapping{})
+
+type FileMappingDie struct {
+	mutable bool
+	r       apisv1beta1.FileMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *FileMappingDie) DieImmutable(immutable bool) *FileMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *FileMappingDie) DieFeed(r apisv1beta1.FileMapping) *FileMappingDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &FileMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *FileMappingDie) DieFeedPtr(r *apisv1beta1.FileMapping) *FileMappingDie {
+	if r == nil {
+		r = &apisv1beta1.FileMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *FileMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *FileMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.FileMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *FileMappingDie) DieRelease() apisv1beta1.FileMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *FileMappingDie) DieReleasePtr() *apisv1beta1.FileMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *FileMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *FileMappingDie) DieStamp(fn func(r *apisv1beta1.FileMapping)) *FileMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *FileMappingDie) DeepCopy() *FileMappingDie {
+	r := *d.r.DeepCopy()
+	return &FileMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Key is the key in the Secret that will be exposed
+func (d *FileMappingDie) Key(v string) *FileMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.FileMapping) {
+		r.Key = v
+	})
+}
+
+// Path is the relative path of the file within the binding volume. Defaults to the key.
+func (d *FileMappingDie) Path(v string) *FileMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.FileMapping) {
+		r.Path = v
+	})
+}
+
+var VolumeOptionsBlank = (&VolumeOptionsDie{}).DieFeed(apisv1beta1.VolumeOptions{})
+
+type VolumeOptionsDie struct {
+	mutable bool
+	r       apisv1beta1.VolumeOptions
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *VolumeOptionsDie) DieImmutable(immutable bool) *VolumeOptionsDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *VolumeOptionsDie) DieFeed(r apisv1beta1.VolumeOptions) *VolumeOptionsDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &VolumeOptionsDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *VolumeOptionsDie) DieFeedPtr(r *apisv1beta1.VolumeOptions) *VolumeOptionsDie {
+	if r == nil {
+		r = &apisv1beta1.VolumeOptions{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *VolumeOptionsDie) DieFeedRawExtension(raw runtime.RawExtension) *VolumeOptionsDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.VolumeOptions{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *VolumeOptionsDie) DieRelease() apisv1beta1.VolumeOptions {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *VolumeOptionsDie) DieReleasePtr() *apisv1beta1.VolumeOptions {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *VolumeOptionsDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *VolumeOptionsDie) DieStamp(fn func(r *apisv1beta1.VolumeOptions)) *VolumeOptionsDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *VolumeOptionsDie) DeepCopy() *VolumeOptionsDie {
+	r := *d.r.DeepCopy()
+	return &VolumeOptionsDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// MountPath is the absolute path within the container the binding volume is mounted at. Defaults to the binding name within `$SERVICE_BINDING_ROOT`.
+func (d *VolumeOptionsDie) MountPath(v string) *VolumeOptionsDie {
+	return d.DieStamp(func(r *apisv1beta1.VolumeOptions) {
+		r.MountPath = v
+	})
+}
+
+// DefaultMode is the mode bits used to set permissions on the projected files. Must be a value between 0 and 0777. Defaults to the Kubernetes default for projected volumes.
+func (d *VolumeOptionsDie) DefaultMode(v *int32) *VolumeOptionsDie {
+	return d.DieStamp(func(r *apisv1beta1.VolumeOptions) {
+		r.DefaultMode = v
+	})
+}
+
+// ReadOnly mounts the binding volume read-only. Defaults to true.
+func (d *VolumeOptionsDie) ReadOnly(v *bool) *VolumeOptionsDie {
+	return d.DieStamp(func(r *apisv1beta1.VolumeOptions) {
+		r.ReadOnly = v
+	})
+}
+
+// Consolidated projects the binding into a single projected volume that is shared by every consolidated binding for the workload, rather than a volume per binding. The binding's entries are projected into the binding name directory of the shared volume, which is mounted at `$SERVICE_BINDING_ROOT`. MountPath, ReadOnly and the workload's containers must not be set.
+func (d *VolumeOptionsDie) Consolidated(v bool) *VolumeOptionsDie {
+	return d.DieStamp(func(r *apisv1beta1.VolumeOptions) {
+		r.Consolidated = v
+	})
+}
+
+var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1beta1.ServiceBindingStatus{})
+
+type ServiceBindingStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingStatusDie) DieFeed(r apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingStatus)) *ServiceBindingStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// ObservedGeneration is the 'Generation' of the ServiceBinding that was last processed by the controller.
+func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.ObservedGeneration = v
+	})
+}
+
+// Conditions are the conditions of this ServiceBinding
+func (d *ServiceBindingStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Conditions = v
+	})
+}
+
+// Binding exposes the projected secret for this ServiceBinding
+func (d *ServiceBindingStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Binding = v
+	})
+}
+
+// Services is the observed state of each service bound by a ServiceBinding that defines Services. The ServiceAvailable condition of the ServiceBinding is only True when every service is available.
+func (d *ServiceBindingStatusDie) Services(v ...apisv1beta1.ServiceBindingServiceStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Services = v
+	})
+}
+
+// Workloads are the workloads the service is bound to. A workload that is no longer referenced by the ServiceBinding, like a workload whose labels no longer match the selector, is unprojected.
+func (d *ServiceBindingStatusDie) Workloads(v ...apisv1beta1.ServiceBindingWorkloadStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Workloads = v
+	})
+}
+
+// WorkloadCount is the number of Workloads
+func (d *ServiceBindingStatusDie) WorkloadCount(v int32) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.WorkloadCount = v
+	})
+}
+
+var ServiceBindingServiceStatusBlank = (&ServiceBindingServiceStatusDie{}).DieFeed(apisv1beta1.ServiceBindingServiceStatus{})
+
+type ServiceBindingServiceStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingServiceStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingServiceStatusDie) DieImmutable(immutable bool) *ServiceBindingServiceStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingServiceStatusDie) DieFeed(r apisv1beta1.ServiceBindingServiceStatus) *ServiceBindingServiceStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingServiceStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceStatus) *ServiceBindingServiceStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingServiceStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingServiceStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingServiceStatusDie) DieRelease() apisv1beta1.ServiceBindingServiceStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingServiceStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingServiceStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceStatus)) *ServiceBindingServiceStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingServiceStatusDie) DeepCopy() *ServiceBindingServiceStatusDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingServiceStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
 }
 
-// Workload is a reference to an object
-func (d *ServiceBindingSpecDie) Workload(v apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Workload = v
+// Name is the name of the service as projected into the workload container
+func (d *ServiceBindingServiceStatusDie) Name(v string) *ServiceBindingServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceStatus) {
+		r.Name = v
 	})
 }
 
-// Service is a reference to an object that fulfills the ProvisionedService duck type
-func (d *ServiceBindingSpecDie) Service(v apisv1beta1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Service = v
+// Conditions are the conditions of the service, only the ServiceAvailable condition is reported
+func (d *ServiceBindingServiceStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceStatus) {
+		r.Conditions = v
 	})
 }
 
-// Env is the collection of mappings from Secret entries to environment variables
-func (d *ServiceBindingSpecDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Env = v
+// Binding exposes the projected secret for the service
+func (d *ServiceBindingServiceStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceStatus) {
+		r.Binding = v
 	})
 }
 
-var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingWorkloadReference{})
+var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingSecretReference{})
 
-type ServiceBindingWorkloadReferenceDie struct {
+type ServiceBindingSecretReferenceDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingWorkloadReference
+	r       apisv1beta1.ServiceBindingSecretReference
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -834,35 +3291,35 @@ func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *Servi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingWorkloadReferenceDie{
+	return &ServiceBindingSecretReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingWorkloadReference{}
+		r = &apisv1beta1.ServiceBindingSecretReference{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingWorkloadReference{}
+	r := apisv1beta1.ServiceBindingSecretReference{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindingSecretReference {
 	if d.mutable {
 		return d.r
 	}
@@ -870,13 +3327,13 @@ func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBin
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingSecretReference {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -885,65 +3342,73 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.Ra
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingWorkloadReferenceDie{
+	return &ServiceBindingSecretReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// API version of the referent.
-func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.APIVersion = v
+// Name of the referent secret. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Name = v
 	})
 }
 
-// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Kind = v
+// Keys are the entries within the referent secret. Only resolved when every entry is projected as an environment variable, or into a consolidated volume.
+func (d *ServiceBindingSecretReferenceDie) Keys(v ...string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Keys = v
 	})
 }
 
-// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Name = v
+// Hash is a digest of the content of the referent secret. Only resolved when workloads are rolled out on rotation.
+func (d *ServiceBindingSecretReferenceDie) Hash(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Hash = v
 	})
 }
 
-// Selector is a query that selects the workload or workloads to bind the service to
-func (d *ServiceBindingWorkloadReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Selector = v
+// Type is the effective type of the binding. The type defined by the spec takes precedence over the `type` entry of the referent secret, which takes precedence over a referent secret of type `servicebinding.io/<type>`.
+func (d *ServiceBindingSecretReferenceDie) Type(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Type = v
 	})
 }
 
-// Containers describes which containers in a Pod should be bound to
-func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Containers = v
+// Provider is the effective provider of the binding. The provider defined by the spec takes precedence over the `provider` entry of the referent secret.
+func (d *ServiceBindingSecretReferenceDie) Provider(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Provider = v
 	})
 }
 
-var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingServiceReference{})
+// Env is the collection of default mappings from Secret entries to environment variables defined by the ClusterBindingTypeProfile for the binding's type. A mapping within the spec for the same environment variable takes precedence.
+func (d *ServiceBindingSecretReferenceDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Env = v
+	})
+}
 
-type ServiceBindingServiceReferenceDie struct {
+var ServiceBindingGrantBlank = (&ServiceBindingGrantDie{}).DieFeed(apisv1beta1.ServiceBindingGrant{})
+
+type ServiceBindingGrantDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ServiceBindingServiceReference
+	r       apisv1beta1.ServiceBindingGrant
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DieImmutable(immutable bool) *ServiceBindingGrantDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -953,35 +3418,37 @@ func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *Servic
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DieFeed(r apisv1beta1.ServiceBindingGrant) *ServiceBindingGrantDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingServiceReferenceDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ServiceBindingGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DieFeedPtr(r *apisv1beta1.ServiceBindingGrant) *ServiceBindingGrantDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingServiceReference{}
+		r = &apisv1beta1.ServiceBindingGrant{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingGrantDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingServiceReference{}
+	r := apisv1beta1.ServiceBindingGrant{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBindingServiceReference {
+func (d *ServiceBindingGrantDie) DieRelease() apisv1beta1.ServiceBindingGrant {
 	if d.mutable {
 		return d.r
 	}
@@ -989,13 +3456,22 @@ func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBind
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceReference {
+func (d *ServiceBindingGrantDie) DieReleasePtr() *apisv1beta1.ServiceBindingGrant {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ServiceBindingGrantDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingGrantDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1004,51 +3480,97 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingGrant)) *ServiceBindingGrantDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingGrantDie) DeepCopy() *ServiceBindingGrantDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingServiceReferenceDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ServiceBindingGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
-// API version of the referent.
-func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+var _ runtime.Object = (*ServiceBindingGrantDie)(nil)
+
+func (d *ServiceBindingGrantDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ServiceBindingGrantDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ServiceBindingGrantDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ServiceBindingGrantDie) UnmarshalJSON(b []byte) error {
+	if d == ServiceBindingGrantBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ServiceBindingGrant{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ServiceBindingGrantDie) APIVersion(v string) *ServiceBindingGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrant) {
 		r.APIVersion = v
 	})
 }
 
-// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingGrantDie) Kind(v string) *ServiceBindingGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrant) {
 		r.Kind = v
 	})
 }
 
-// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
-		r.Name = v
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ServiceBindingGrantDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrant) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
 	})
 }
 
-var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1beta1.EnvMapping{})
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ServiceBindingGrantDie) SpecDie(fn func(d *ServiceBindingGrantSpecDie)) *ServiceBindingGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrant) {
+		d := ServiceBindingGrantSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
 
-type EnvMappingDie struct {
+func (d *ServiceBindingGrantDie) Spec(v apisv1beta1.ServiceBindingGrantSpec) *ServiceBindingGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrant) {
+		r.Spec = v
+	})
+}
+
+var ServiceBindingGrantSpecBlank = (&ServiceBindingGrantSpecDie{}).DieFeed(apisv1beta1.ServiceBindingGrantSpec{})
+
+type ServiceBindingGrantSpecDie struct {
 	mutable bool
-	r       apisv1beta1.EnvMapping
+	r       apisv1beta1.ServiceBindingGrantSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DieImmutable(immutable bool) *ServiceBindingGrantSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1058,35 +3580,35 @@ func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *EnvMappingDie) DieFeed(r apisv1beta1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DieFeed(r apisv1beta1.ServiceBindingGrantSpec) *ServiceBindingGrantSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &EnvMappingDie{
+	return &ServiceBindingGrantSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *EnvMappingDie) DieFeedPtr(r *apisv1beta1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingGrantSpec) *ServiceBindingGrantSpecDie {
 	if r == nil {
-		r = &apisv1beta1.EnvMapping{}
+		r = &apisv1beta1.ServiceBindingGrantSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingGrantSpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.EnvMapping{}
+	r := apisv1beta1.ServiceBindingGrantSpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
+func (d *ServiceBindingGrantSpecDie) DieRelease() apisv1beta1.ServiceBindingGrantSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -1094,13 +3616,13 @@ func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *EnvMappingDie) DieReleasePtr() *apisv1beta1.EnvMapping {
+func (d *ServiceBindingGrantSpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingGrantSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingGrantSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1109,44 +3631,44 @@ func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *EnvMappingDie) DieStamp(fn func(r *apisv1beta1.EnvMapping)) *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingGrantSpec)) *ServiceBindingGrantSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+func (d *ServiceBindingGrantSpecDie) DeepCopy() *ServiceBindingGrantSpecDie {
 	r := *d.r.DeepCopy()
-	return &EnvMappingDie{
+	return &ServiceBindingGrantSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name is the name of the environment variable
-func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
-		r.Name = v
+// From is the collection of bindings, in other namespaces, permitted to reference services in the grant's namespace
+func (d *ServiceBindingGrantSpecDie) From(v ...apisv1beta1.ServiceBindingGrantFrom) *ServiceBindingGrantSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantSpec) {
+		r.From = v
 	})
 }
 
-// Key is the key in the Secret that will be exposed
-func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
-		r.Key = v
+// To is the collection of services, in the grant's namespace, that may be referenced
+func (d *ServiceBindingGrantSpecDie) To(v ...apisv1beta1.ServiceBindingGrantTo) *ServiceBindingGrantSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantSpec) {
+		r.To = v
 	})
 }
 
-var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1beta1.ServiceBindingStatus{})
+var ServiceBindingGrantFromBlank = (&ServiceBindingGrantFromDie{}).DieFeed(apisv1beta1.ServiceBindingGrantFrom{})
 
-type ServiceBindingStatusDie struct {
+type ServiceBindingGrantFromDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingStatus
+	r       apisv1beta1.ServiceBindingGrantFrom
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DieImmutable(immutable bool) *ServiceBindingGrantFromDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1156,35 +3678,35 @@ func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingSt
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingStatusDie) DieFeed(r apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DieFeed(r apisv1beta1.ServiceBindingGrantFrom) *ServiceBindingGrantFromDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingStatusDie{
+	return &ServiceBindingGrantFromDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DieFeedPtr(r *apisv1beta1.ServiceBindingGrantFrom) *ServiceBindingGrantFromDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingStatus{}
+		r = &apisv1beta1.ServiceBindingGrantFrom{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingGrantFromDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingStatus{}
+	r := apisv1beta1.ServiceBindingGrantFrom{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus {
+func (d *ServiceBindingGrantFromDie) DieRelease() apisv1beta1.ServiceBindingGrantFrom {
 	if d.mutable {
 		return d.r
 	}
@@ -1192,13 +3714,13 @@ func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingStatus {
+func (d *ServiceBindingGrantFromDie) DieReleasePtr() *apisv1beta1.ServiceBindingGrantFrom {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingGrantFromDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1207,51 +3729,51 @@ func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingStatus)) *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingGrantFrom)) *ServiceBindingGrantFromDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
+func (d *ServiceBindingGrantFromDie) DeepCopy() *ServiceBindingGrantFromDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingStatusDie{
+	return &ServiceBindingGrantFromDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// ObservedGeneration is the 'Generation' of the ServiceBinding that was last processed by the controller.
-func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.ObservedGeneration = v
+// Group is the API group of the referencing binding, like `servicebinding.io`
+func (d *ServiceBindingGrantFromDie) Group(v string) *ServiceBindingGrantFromDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantFrom) {
+		r.Group = v
 	})
 }
 
-// Conditions are the conditions of this ServiceBinding
-func (d *ServiceBindingStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.Conditions = v
+// Kind is the kind of the referencing binding, like `ServiceBinding`
+func (d *ServiceBindingGrantFromDie) Kind(v string) *ServiceBindingGrantFromDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantFrom) {
+		r.Kind = v
 	})
 }
 
-// Binding exposes the projected secret for this ServiceBinding
-func (d *ServiceBindingStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.Binding = v
+// Namespace is the namespace of the referencing binding
+func (d *ServiceBindingGrantFromDie) Namespace(v string) *ServiceBindingGrantFromDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantFrom) {
+		r.Namespace = v
 	})
 }
 
-var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingSecretReference{})
+var ServiceBindingGrantToBlank = (&ServiceBindingGrantToDie{}).DieFeed(apisv1beta1.ServiceBindingGrantTo{})
 
-type ServiceBindingSecretReferenceDie struct {
+type ServiceBindingGrantToDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingSecretReference
+	r       apisv1beta1.ServiceBindingGrantTo
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DieImmutable(immutable bool) *ServiceBindingGrantToDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1261,35 +3783,35 @@ func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *Service
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DieFeed(r apisv1beta1.ServiceBindingGrantTo) *ServiceBindingGrantToDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingGrantToDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DieFeedPtr(r *apisv1beta1.ServiceBindingGrantTo) *ServiceBindingGrantToDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingSecretReference{}
+		r = &apisv1beta1.ServiceBindingGrantTo{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingGrantToDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingSecretReference{}
+	r := apisv1beta1.ServiceBindingGrantTo{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindingSecretReference {
+func (d *ServiceBindingGrantToDie) DieRelease() apisv1beta1.ServiceBindingGrantTo {
 	if d.mutable {
 		return d.r
 	}
@@ -1297,13 +3819,13 @@ func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindi
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingSecretReference {
+func (d *ServiceBindingGrantToDie) DieReleasePtr() *apisv1beta1.ServiceBindingGrantTo {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingGrantToDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1312,24 +3834,38 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawE
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingGrantTo)) *ServiceBindingGrantToDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingGrantToDie) DeepCopy() *ServiceBindingGrantToDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingGrantToDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name of the referent secret. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+// Group is the API group of the referenced service. The empty string is the core API group.
+func (d *ServiceBindingGrantToDie) Group(v string) *ServiceBindingGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantTo) {
+		r.Group = v
+	})
+}
+
+// Kind is the kind of the referenced service, like `Secret`
+func (d *ServiceBindingGrantToDie) Kind(v string) *ServiceBindingGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantTo) {
+		r.Kind = v
+	})
+}
+
+// Name is the name of the referenced service. When empty, every service of the group and kind may be referenced.
+func (d *ServiceBindingGrantToDie) Name(v string) *ServiceBindingGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingGrantTo) {
 		r.Name = v
 	})
 }
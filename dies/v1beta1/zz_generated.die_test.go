@@ -27,6 +27,105 @@ import (
 	testing "dies.dev/testing"
 )
 
+func TestClusterBindingTypeProfileDie_MissingMethods(t *testingx.T) {
+	die := ClusterBindingTypeProfileBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterBindingTypeProfileDie: %s", diff.List())
+	}
+}
+
+func TestClusterBindingTypeProfileSpecDie_MissingMethods(t *testingx.T) {
+	die := ClusterBindingTypeProfileSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterBindingTypeProfileSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingSpecDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingStatusDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingSpecDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingTemplateDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingTemplateBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingTemplateDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingSecretDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingSecretBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingSecretDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingEntryDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingEntryBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingEntryDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingSecretKeyRefDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingSecretKeyRefBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingSecretKeyRefDie: %s", diff.List())
+	}
+}
+
 func TestClusterWorkloadResourceMappingDie_MissingMethods(t *testingx.T) {
 	die := ClusterWorkloadResourceMappingBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -54,6 +153,15 @@ func TestClusterWorkloadResourceMappingTemplateDie_MissingMethods(t *testingx.T)
 	}
 }
 
+func TestClusterWorkloadResourceMappingPodTemplateDie_MissingMethods(t *testingx.T) {
+	die := ClusterWorkloadResourceMappingPodTemplateBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterWorkloadResourceMappingPodTemplateDie: %s", diff.List())
+	}
+}
+
 func TestClusterWorkloadResourceMappingContainerDie_MissingMethods(t *testingx.T) {
 	die := ClusterWorkloadResourceMappingContainerBlank
 	ignore := []string{}
@@ -99,6 +207,15 @@ func TestServiceBindingServiceReferenceDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingServiceEntryDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingServiceEntryBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingServiceEntryDie: %s", diff.List())
+	}
+}
+
 func TestEnvMappingDie_MissingMethods(t *testingx.T) {
 	die := EnvMappingBlank
 	ignore := []string{}
@@ -108,6 +225,33 @@ func TestEnvMappingDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestEnvFromMappingDie_MissingMethods(t *testingx.T) {
+	die := EnvFromMappingBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for EnvFromMappingDie: %s", diff.List())
+	}
+}
+
+func TestFileMappingDie_MissingMethods(t *testingx.T) {
+	die := FileMappingBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for FileMappingDie: %s", diff.List())
+	}
+}
+
+func TestVolumeOptionsDie_MissingMethods(t *testingx.T) {
+	die := VolumeOptionsBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for VolumeOptionsDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingStatusDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingStatusBlank
 	ignore := []string{}
@@ -117,6 +261,15 @@ func TestServiceBindingStatusDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingServiceStatusDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingServiceStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingServiceStatusDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingSecretReferenceDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingSecretReferenceBlank
 	ignore := []string{}
@@ -125,3 +278,39 @@ func TestServiceBindingSecretReferenceDie_MissingMethods(t *testingx.T) {
 		t.Errorf("found missing fields for ServiceBindingSecretReferenceDie: %s", diff.List())
 	}
 }
+
+func TestServiceBindingGrantDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingGrantBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingGrantDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingGrantSpecDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingGrantSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingGrantSpecDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingGrantFromDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingGrantFromBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingGrantFromDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingGrantToDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingGrantToBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingGrantToDie: %s", diff.List())
+	}
+}
@@ -61,6 +61,9 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&controllers.LegacyWorkloadFieldManager, "legacy-workload-field-manager", controllers.LegacyWorkloadFieldManager,
+		"The field manager of workloads updated by earlier versions of the controller. "+
+			"The fields it manages are migrated to the field manager of each binding.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -94,6 +97,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "ServiceBinding")
 		os.Exit(1)
 	}
+	clusterServiceBindingConfig := reconcilers.NewConfig(mgr, &servicebindingv1beta1.ClusterServiceBinding{}, syncPeriod)
+	clusterServiceBindingController, err := controllers.ClusterServiceBindingReconciler(
+		clusterServiceBindingConfig,
+	).SetupWithManagerYieldingController(ctx, mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterServiceBinding")
+		os.Exit(1)
+	}
 	if err = (&servicebindingv1beta1.ServiceBinding{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ServiceBinding")
 		os.Exit(1)
@@ -102,6 +113,22 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterWorkloadResourceMapping")
 		os.Exit(1)
 	}
+	if err = (&servicebindingv1beta1.ClusterBindingTypeProfile{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterBindingTypeProfile")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ClusterServiceResourceMapping{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterServiceResourceMapping")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ServiceBindingGrant{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ServiceBindingGrant")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ClusterServiceBinding{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterServiceBinding")
+		os.Exit(1)
+	}
 
 	if err = controllers.AdmissionProjectorReconciler(
 		config,
@@ -112,7 +139,7 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AdmissionProjector")
 		os.Exit(1)
 	}
-	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config).Build())
+	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config, serviceBindingController, clusterServiceBindingController).Build())
 
 	if err = controllers.TriggerReconciler(
 		config,
@@ -123,7 +150,7 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Trigger")
 		os.Exit(1)
 	}
-	mgr.GetWebhookServer().Register("/trigger", controllers.TriggerWebhook(config, serviceBindingController).Build())
+	mgr.GetWebhookServer().Register("/trigger", controllers.TriggerWebhook(config, serviceBindingController, clusterServiceBindingConfig, clusterServiceBindingController).Build())
 
 	//+kubebuilder:scaffold:builder
 
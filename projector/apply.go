@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ErrAtomicList is returned when the projection changes a list whose items are not keyed. Server-side apply replaces
+// such a list as a whole, dropping items owned by others, like the sources of the consolidated volume.
+var ErrAtomicList = errors.New("the projection changes a list whose items are not keyed")
+
+// ApplyConfiguration returns the fields of the projected workload that are added, or changed, relative to the
+// unprojected workload, as the configuration for a server-side apply. The configuration only contains the fields that
+// are projected, along with the identity of the workload and the key of each list item that leads to a projected
+// field. Items of a list are keyed by their `mountPath`, for a volume mount, or their `name`. This is a heuristic for the
+// lists a binding projects into, volumes, volume mounts, env vars and containers, rather than the list map keys the API
+// server uses, a list keyed by other fields, like the `containerPort` and `protocol` of a port, must not be projected.
+// The configuration should only be applied to workloads whose schema is known to key these lists, like the built-in
+// workload kinds.
+//
+// Fields that are not in the configuration, like the fields of a binding that is no longer projected, are removed by
+// the API server, unless another field manager owns them.
+func ApplyConfiguration(unprojected, projected runtime.Object) (*unstructured.Unstructured, error) {
+	base, err := runtime.DefaultUnstructuredConverter.ToUnstructured(unprojected)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(projected)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := applyMap(base, desired)
+	if err != nil {
+		return nil, err
+	}
+	config := &unstructured.Unstructured{Object: content}
+	workload := &unstructured.Unstructured{Object: desired}
+	config.SetAPIVersion(workload.GetAPIVersion())
+	config.SetKind(workload.GetKind())
+	config.SetNamespace(workload.GetNamespace())
+	config.SetName(workload.GetName())
+	return config, nil
+}
+
+func applyMap(base, desired map[string]interface{}) (map[string]interface{}, error) {
+	config := map[string]interface{}{}
+	for key, value := range desired {
+		existing, ok := base[key]
+		if !ok {
+			config[key] = runtime.DeepCopyJSONValue(value)
+			continue
+		}
+		if equality.Semantic.DeepEqual(existing, value) {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			e, ok := existing.(map[string]interface{})
+			if !ok {
+				config[key] = runtime.DeepCopyJSONValue(value)
+				continue
+			}
+			m, err := applyMap(e, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			if len(m) != 0 {
+				config[key] = m
+			}
+		case []interface{}:
+			e, ok := existing.([]interface{})
+			if !ok {
+				config[key] = runtime.DeepCopyJSONValue(value)
+				continue
+			}
+			l, err := applyList(e, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			if len(l) != 0 {
+				config[key] = l
+			}
+		default:
+			config[key] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+	return config, nil
+}
+
+func applyList(base, desired []interface{}) ([]interface{}, error) {
+	key := listKey(append(append([]interface{}{}, base...), desired...))
+	if key == "" {
+		return nil, ErrAtomicList
+	}
+	existing := map[interface{}]map[string]interface{}{}
+	for _, item := range base {
+		m := item.(map[string]interface{})
+		existing[m[key]] = m
+	}
+	config := []interface{}{}
+	for _, item := range desired {
+		m := item.(map[string]interface{})
+		e, ok := existing[m[key]]
+		if !ok {
+			config = append(config, runtime.DeepCopyJSONValue(m))
+			continue
+		}
+		if equality.Semantic.DeepEqual(e, m) {
+			continue
+		}
+		c, err := applyMap(e, m)
+		if err != nil {
+			return nil, err
+		}
+		c[key] = m[key]
+		config = append(config, c)
+	}
+	return config, nil
+}
+
+// listKey returns the field that keys each item of the list, or an empty string when the items are not keyed. The key is
+// the first of `mountPath` and `name` that every item has, which matches the list map keys of the lists a binding
+// projects into.
+func listKey(items []interface{}) string {
+	for _, key := range []string{"mountPath", "name"} {
+		keyed := true
+		for _, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				keyed = false
+				break
+			}
+			if _, ok := m[key].(string); !ok {
+				keyed = false
+				break
+			}
+		}
+		if keyed {
+			return key
+		}
+	}
+	return ""
+}
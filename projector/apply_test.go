@@ -0,0 +1,406 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+func TestApplyConfiguration(t *testing.T) {
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "USERNAME",
+					Key:  "username",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	workload := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "my-namespace",
+			Name:            "my-workload",
+			ResourceVersion: "999",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"preserve": "me",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "hello",
+							Image: "scratch",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "LOG_LEVEL",
+									Value: "debug",
+								},
+							},
+						},
+						{
+							Name:  "sidecar",
+							Image: "scratch",
+						},
+					},
+				},
+			},
+		},
+	}
+	projectedWorkload := workload.DeepCopy()
+	if err := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})).Project(context.TODO(), binding, projectedWorkload); err != nil {
+		t.Fatalf("Project() unexpected err: %v", err)
+	}
+	// the base for a server-side apply is the projected workload with the binding unprojected
+	unprojectedWorkload := projectedWorkload.DeepCopy()
+	if err := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})).(ServiceBindingApplyProjector).UnprojectForApply(context.TODO(), binding, unprojectedWorkload); err != nil {
+		t.Fatalf("UnprojectForApply() unexpected err: %v", err)
+	}
+	identity := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"namespace": "my-namespace",
+			"name":      "my-workload",
+		},
+	}
+	projectedContainer := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"env": []interface{}{
+				map[string]interface{}{
+					"name":  "SERVICE_BINDING_ROOT",
+					"value": "/bindings",
+				},
+				map[string]interface{}{
+					"name": "USERNAME",
+					"valueFrom": map[string]interface{}{
+						"secretKeyRef": map[string]interface{}{
+							"name": "my-secret",
+							"key":  "username",
+						},
+					},
+				},
+			},
+			"volumeMounts": []interface{}{
+				map[string]interface{}{
+					"name":      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+					"mountPath": "/bindings/my-binding",
+					"readOnly":  true,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		unprojected runtime.Object
+		projected   runtime.Object
+		expected    map[string]interface{}
+		expectedErr error
+	}{
+		{
+			name:        "project",
+			unprojected: workload,
+			projected:   projectedWorkload,
+			expected: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"namespace": "my-namespace",
+					"name":      "my-workload",
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"annotations": map[string]interface{}{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+							},
+						},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								projectedContainer("hello"),
+								projectedContainer("sidecar"),
+							},
+							"volumes": []interface{}{
+								map[string]interface{}{
+									"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									"projected": map[string]interface{}{
+										"sources": []interface{}{
+											map[string]interface{}{
+												"secret": map[string]interface{}{
+													"name": "my-secret",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "project from the unprojected workload",
+			unprojected: unprojectedWorkload,
+			projected:   projectedWorkload,
+			expected: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"namespace": "my-namespace",
+					"name":      "my-workload",
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"annotations": map[string]interface{}{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+							},
+						},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								projectedContainer("hello"),
+								projectedContainer("sidecar"),
+							},
+							"volumes": []interface{}{
+								map[string]interface{}{
+									"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									"projected": map[string]interface{}{
+										"sources": []interface{}{
+											map[string]interface{}{
+												"secret": map[string]interface{}{
+													"name": "my-secret",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "unproject",
+			unprojected: workload,
+			projected:   workload,
+			expected:    identity,
+		},
+		{
+			name: "change projected field",
+			unprojected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata": map[string]interface{}{
+						"namespace": "my-namespace",
+						"name":      "my-workload",
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "hello",
+										"image": "scratch",
+										"env": []interface{}{
+											map[string]interface{}{
+												"name":  "USERNAME",
+												"value": "admin",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			projected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata": map[string]interface{}{
+						"namespace": "my-namespace",
+						"name":      "my-workload",
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "hello",
+										"image": "scratch",
+										"env": []interface{}{
+											map[string]interface{}{
+												"name":  "USERNAME",
+												"value": "root",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"namespace": "my-namespace",
+					"name":      "my-workload",
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name": "hello",
+									"env": []interface{}{
+										map[string]interface{}{
+											"name":  "USERNAME",
+											"value": "root",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "change list without keyed items",
+			unprojected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata": map[string]interface{}{
+						"namespace": "my-namespace",
+						"name":      "my-workload",
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"volumes": []interface{}{
+									map[string]interface{}{
+										"name": "servicebinding-consolidated",
+										"projected": map[string]interface{}{
+											"sources": []interface{}{
+												map[string]interface{}{
+													"secret": map[string]interface{}{
+														"name": "other-secret",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			projected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata": map[string]interface{}{
+						"namespace": "my-namespace",
+						"name":      "my-workload",
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"volumes": []interface{}{
+									map[string]interface{}{
+										"name": "servicebinding-consolidated",
+										"projected": map[string]interface{}{
+											"sources": []interface{}{
+												map[string]interface{}{
+													"secret": map[string]interface{}{
+														"name": "other-secret",
+													},
+												},
+												map[string]interface{}{
+													"secret": map[string]interface{}{
+														"name": "my-secret",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: ErrAtomicList,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ApplyConfiguration(c.unprojected, c.projected)
+			if !errors.Is(err, c.expectedErr) {
+				t.Fatalf("ApplyConfiguration() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr != nil {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual.Object); diff != "" {
+				t.Errorf("ApplyConfiguration() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -37,9 +37,21 @@ const (
 	SecretAnnotationPrefix   = Group + "/secret-"
 	TypeAnnotationPrefix     = Group + "/type-"
 	ProviderAnnotationPrefix = Group + "/provider-"
+	HashAnnotationPrefix     = Group + "/hash-"
+	PathAnnotationPrefix     = Group + "/path-"
+	ConsolidatedVolumeName   = VolumePrefix + "consolidated"
+	EnvSecretPrefix          = VolumePrefix + "env-"
 )
 
+// EnvSecretName is the name of the Secret, in the service binding's namespace, that holds the values rendered from the
+// binding's env templates.
+func EnvSecretName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", EnvSecretPrefix, UnitID(binding))
+}
+
 var _ ServiceBindingProjector = (*serviceBindingProjector)(nil)
+var _ ServiceBindingPlanner = (*serviceBindingProjector)(nil)
+var _ ServiceBindingApplyProjector = (*serviceBindingProjector)(nil)
 
 type serviceBindingProjector struct {
 	mappingSource MappingSource
@@ -58,12 +70,19 @@ func (p *serviceBindingProjector) Project(ctx context.Context, binding *serviceb
 	if err != nil {
 		return err
 	}
-	mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+	mpts, err := NewMetaPodTemplates(ctx, workload, mapping)
 	if err != nil {
 		return err
 	}
-	p.project(binding, mpt)
-	return mpt.WriteToWorkload(ctx)
+	for _, mpt := range mpts {
+		if err := p.projectUnits(binding, mpt); err != nil {
+			return err
+		}
+		if err := mpt.WriteToWorkload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *serviceBindingProjector) Unproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error {
@@ -71,30 +90,87 @@ func (p *serviceBindingProjector) Unproject(ctx context.Context, binding *servic
 	if err != nil {
 		return err
 	}
-	mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+	mpts, err := NewMetaPodTemplates(ctx, workload, mapping)
 	if err != nil {
 		return err
 	}
-	p.unproject(binding, mpt)
-	return mpt.WriteToWorkload(ctx)
+	for _, mpt := range mpts {
+		p.unprojectUnits(binding, mpt)
+		if err := mpt.WriteToWorkload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *serviceBindingProjector) UnprojectForApply(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error {
+	mapping, err := p.mappingSource.LookupMapping(ctx, workload)
+	if err != nil {
+		return err
+	}
+	mpts, err := NewMetaPodTemplates(ctx, workload, mapping)
+	if err != nil {
+		return err
+	}
+	for _, mpt := range mpts {
+		p.unprojectUnits(binding, mpt)
+		p.unprojectServiceBindingRoot(binding, mpt)
+		if err := mpt.WriteToWorkload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectUnits projects each service unit of the binding into the pod template, after unprojecting the units that are
+// no longer part of the binding
+func (p *serviceBindingProjector) projectUnits(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) error {
+	units := ServiceUnits(binding)
+	for _, unit := range p.staleUnits(binding, units, mpt) {
+		p.unproject(unit, mpt)
+	}
+	for _, unit := range units {
+		if err := p.project(unit, mpt); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (p *serviceBindingProjector) project(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+// unprojectUnits unprojects each service unit of the binding from the pod template, including units that are no longer
+// part of the binding
+func (p *serviceBindingProjector) unprojectUnits(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	units := ServiceUnits(binding)
+	for _, unit := range append(p.staleUnits(binding, units, mpt), units...) {
+		p.unproject(unit, mpt)
+	}
+}
+
+func (p *serviceBindingProjector) project(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) error {
 	// rather than attempt to merge an existing binding, unproject it
 	p.unproject(binding, mpt)
 
 	if p.secretName(binding) == "" {
 		// no secret to bind
-		return
+		return nil
 	}
-	p.projectVolume(binding, mpt)
+	if p.isConsolidated(binding) {
+		p.projectConsolidatedVolume(binding, mpt)
+	} else {
+		p.projectVolume(binding, mpt)
+	}
+	p.hashAnnotation(binding, mpt)
 	for i := range mpt.Containers {
-		p.projectContainer(binding, mpt, &mpt.Containers[i])
+		if err := p.projectContainer(binding, mpt, &mpt.Containers[i], p.containerName(&mpt.Containers[i], i)); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (p *serviceBindingProjector) unproject(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
 	p.unprojectVolume(binding, mpt)
+	p.unprojectConsolidatedVolume(binding, mpt)
 	for i := range mpt.Containers {
 		p.unprojectContainer(binding, mpt, &mpt.Containers[i])
 	}
@@ -103,6 +179,8 @@ func (p *serviceBindingProjector) unproject(binding *servicebindingv1beta1.Servi
 	delete(mpt.Annotations, p.secretAnnotationName(binding))
 	delete(mpt.Annotations, p.typeAnnotationName(binding))
 	delete(mpt.Annotations, p.providerAnnotationName(binding))
+	delete(mpt.Annotations, p.hashAnnotationName(binding))
+	delete(mpt.Annotations, p.pathAnnotationName(binding))
 }
 
 func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
@@ -116,13 +194,18 @@ func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.S
 							LocalObjectReference: corev1.LocalObjectReference{
 								Name: p.secretAnnotation(binding, mpt),
 							},
+							Items: p.secretItems(binding),
 						},
 					},
 				},
 			},
 		},
 	}
-	if binding.Spec.Type != "" {
+	if binding.Spec.Volume != nil && binding.Spec.Volume.DefaultMode != nil {
+		mode := *binding.Spec.Volume.DefaultMode
+		volume.VolumeSource.Projected.DefaultMode = &mode
+	}
+	if p.bindingType(binding) != "" {
 		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
 			corev1.VolumeProjection{
 				DownwardAPI: &corev1.DownwardAPIProjection{
@@ -138,7 +221,7 @@ func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.S
 			},
 		)
 	}
-	if binding.Spec.Provider != "" {
+	if p.bindingProvider(binding) != "" {
 		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
 			corev1.VolumeProjection{
 				DownwardAPI: &corev1.DownwardAPIProjection{
@@ -156,7 +239,10 @@ func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.S
 	}
 
 	mpt.Volumes = append(mpt.Volumes, volume)
+	p.sortVolumes(mpt)
+}
 
+func (p *serviceBindingProjector) sortVolumes(mpt *metaPodTemplate) {
 	// sort projected volumes
 	sort.SliceStable(mpt.Volumes, func(i, j int) bool {
 		ii := mpt.Volumes[i]
@@ -176,6 +262,24 @@ func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.S
 	})
 }
 
+func (p *serviceBindingProjector) secretItems(binding *servicebindingv1beta1.ServiceBinding) []corev1.KeyToPath {
+	if len(binding.Spec.Files) == 0 {
+		// project every entry in the secret
+		return nil
+	}
+	items := make([]corev1.KeyToPath, len(binding.Spec.Files))
+	for i, f := range binding.Spec.Files {
+		items[i] = corev1.KeyToPath{
+			Key:  f.Key,
+			Path: f.Path,
+		}
+		if items[i].Path == "" {
+			items[i].Path = f.Key
+		}
+	}
+	return items
+}
+
 func (p *serviceBindingProjector) unprojectVolume(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
 	volumes := []corev1.Volume{}
 	projected := p.volumeName(binding)
@@ -187,12 +291,18 @@ func (p *serviceBindingProjector) unprojectVolume(binding *servicebindingv1beta1
 	mpt.Volumes = volumes
 }
 
-func (p *serviceBindingProjector) projectContainer(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
+func (p *serviceBindingProjector) projectContainer(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, container string) error {
 	if !p.isContainerBindable(binding, mc) {
-		return
+		return nil
+	}
+	if p.isConsolidated(binding) {
+		if err := p.projectConsolidatedVolumeMount(binding, mpt, mc, container); err != nil {
+			return err
+		}
+	} else if err := p.projectVolumeMount(binding, mpt, mc, container); err != nil {
+		return err
 	}
-	p.projectVolumeMount(binding, mc)
-	p.projectEnv(binding, mpt, mc)
+	return p.projectEnv(binding, mpt, mc, container)
 }
 
 func (p *serviceBindingProjector) unprojectContainer(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
@@ -200,13 +310,33 @@ func (p *serviceBindingProjector) unprojectContainer(binding *servicebindingv1be
 	p.unprojectEnv(binding, mpt, mc)
 }
 
-func (p *serviceBindingProjector) projectVolumeMount(binding *servicebindingv1beta1.ServiceBinding, mc *metaContainer) {
-	mc.VolumeMounts = append(mc.VolumeMounts, corev1.VolumeMount{
+func (p *serviceBindingProjector) projectVolumeMount(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, container string) error {
+	volumeMount := corev1.VolumeMount{
 		Name:      p.volumeName(binding),
 		ReadOnly:  true,
 		MountPath: path.Join(p.serviceBindingRoot(mc), binding.Spec.Name),
-	})
+	}
+	if options := binding.Spec.Volume; options != nil {
+		if options.MountPath != "" {
+			volumeMount.MountPath = options.MountPath
+		}
+		if options.ReadOnly != nil {
+			volumeMount.ReadOnly = *options.ReadOnly
+		}
+	}
+	if err := p.checkVolumeMountCollision(mc, container, volumeMount.MountPath); err != nil {
+		return err
+	}
+	if err := p.checkConsolidatedCollision(binding, mpt, mc, container, volumeMount.MountPath); err != nil {
+		return err
+	}
+	mc.VolumeMounts = append(mc.VolumeMounts, volumeMount)
+	p.sortVolumeMounts(mc)
+
+	return nil
+}
 
+func (p *serviceBindingProjector) sortVolumeMounts(mc *metaContainer) {
 	// sort projected volume mounts
 	sort.SliceStable(mc.VolumeMounts, func(i, j int) bool {
 		ii := mc.VolumeMounts[i]
@@ -237,9 +367,26 @@ func (p *serviceBindingProjector) unprojectVolumeMount(binding *servicebindingv1
 	mc.VolumeMounts = mounts
 }
 
-func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
-	for _, e := range binding.Spec.Env {
-		if e.Key == "type" && binding.Spec.Type != "" {
+func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, container string) error {
+	for _, e := range p.envMappings(binding) {
+		if err := p.checkEnvCollision(mpt, mc, container, e.Name); err != nil {
+			return err
+		}
+		if e.Template != "" {
+			mc.Env = append(mc.Env, corev1.EnvVar{
+				Name: e.Name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: EnvSecretName(binding),
+						},
+						Key: e.Name,
+					},
+				},
+			})
+			continue
+		}
+		if e.Key == "type" && p.bindingType(binding) != "" {
 			mc.Env = append(mc.Env, corev1.EnvVar{
 				Name: e.Name,
 				ValueFrom: &corev1.EnvVarSource{
@@ -250,7 +397,7 @@ func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.Serv
 			})
 			continue
 		}
-		if e.Key == "provider" && binding.Spec.Provider != "" {
+		if e.Key == "provider" && p.bindingProvider(binding) != "" {
 			mc.Env = append(mc.Env, corev1.EnvVar{
 				Name: e.Name,
 				ValueFrom: &corev1.EnvVarSource{
@@ -292,11 +439,62 @@ func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.Serv
 		// preserve order of non-projected items
 		return false
 	})
+
+	return nil
+}
+
+func (p *serviceBindingProjector) envMappings(binding *servicebindingv1beta1.ServiceBinding) []servicebindingv1beta1.EnvMapping {
+	if binding.Status.Binding == nil {
+		return binding.Spec.Env
+	}
+	names := sets.NewString()
+	for _, e := range binding.Spec.Env {
+		names.Insert(e.Name)
+	}
+	mappings := []servicebindingv1beta1.EnvMapping{}
+	for _, e := range binding.Status.Binding.Env {
+		if names.Has(e.Name) {
+			// explicit mappings take precedence over the binding type profile
+			continue
+		}
+		names.Insert(e.Name)
+		mappings = append(mappings, e)
+	}
+	if binding.Spec.EnvFrom == nil {
+		return append(mappings, binding.Spec.Env...)
+	}
+	for _, key := range binding.Status.Binding.Keys {
+		name := envVarName(binding.Spec.EnvFrom.Prefix, key)
+		if names.Has(name) {
+			// explicit mappings, and keys that normalize to the same name, take precedence
+			continue
+		}
+		names.Insert(name)
+		mappings = append(mappings, servicebindingv1beta1.EnvMapping{Name: name, Key: key})
+	}
+	return append(mappings, binding.Spec.Env...)
+}
+
+// envVarName normalizes the prefixed Secret key into an environment variable name that is a valid C identifier. The name
+// is upper cased, unsupported characters are replaced with an underscore and a leading digit is prefixed with an
+// underscore.
+func envVarName(prefix, key string) string {
+	name := []rune(strings.ToUpper(prefix + key))
+	for i, r := range name {
+		if r != '_' && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			name[i] = '_'
+		}
+	}
+	if len(name) == 0 || (name[0] >= '0' && name[0] <= '9') {
+		return "_" + string(name)
+	}
+	return string(name)
 }
 
 func (p *serviceBindingProjector) unprojectEnv(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
 	env := []corev1.EnvVar{}
 	secret := mpt.Annotations[p.secretAnnotationName(binding)]
+	envSecret := EnvSecretName(binding)
 	typeFieldPath := fmt.Sprintf("metadata.annotations['%s']", p.typeAnnotationName(binding))
 	providerFieldPath := fmt.Sprintf("metadata.annotations['%s']", p.providerAnnotationName(binding))
 	for _, e := range mc.Env {
@@ -306,6 +504,10 @@ func (p *serviceBindingProjector) unprojectEnv(binding *servicebindingv1beta1.Se
 			// projected from secret
 			remove = true
 		}
+		if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && e.ValueFrom.SecretKeyRef.Name == envSecret {
+			// rendered from env template
+			remove = true
+		}
 		if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil {
 			if e.ValueFrom.FieldRef.FieldPath == typeFieldPath {
 				// custom type env var
@@ -350,11 +552,38 @@ func (p *serviceBindingProjector) serviceBindingRoot(mc *metaContainer) string {
 	return serviceBindingRoot.Value
 }
 
+// unprojectServiceBindingRoot removes the SERVICE_BINDING_ROOT env var from each container that a service unit of the
+// binding is projected into
+func (p *serviceBindingProjector) unprojectServiceBindingRoot(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	for _, unit := range ServiceUnits(binding) {
+		if p.secretName(unit) == "" {
+			continue
+		}
+		for i := range mpt.Containers {
+			mc := &mpt.Containers[i]
+			if !p.isContainerBindable(unit, mc) {
+				continue
+			}
+			env := []corev1.EnvVar{}
+			for _, e := range mc.Env {
+				if e.Name != ServiceBindingRootEnv {
+					env = append(env, e)
+				}
+			}
+			mc.Env = env
+		}
+	}
+}
+
 func (p *serviceBindingProjector) isProjectedEnv(e corev1.EnvVar, secrets sets.String) bool {
 	if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && secrets.Has(e.ValueFrom.SecretKeyRef.Name) {
 		// projected from secret
 		return true
 	}
+	if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && strings.HasPrefix(e.ValueFrom.SecretKeyRef.Name, EnvSecretPrefix) {
+		// rendered from env template
+		return true
+	}
 	if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil && strings.HasPrefix(e.ValueFrom.FieldRef.FieldPath, fmt.Sprintf("metadata.annotations['%s", Group)) {
 		// projected custom type or annotation
 		return true
@@ -390,29 +619,66 @@ func (p *serviceBindingProjector) secretAnnotation(binding *servicebindingv1beta
 }
 
 func (p *serviceBindingProjector) secretAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
-	return fmt.Sprintf("%s%s", SecretAnnotationPrefix, binding.UID)
+	return fmt.Sprintf("%s%s", SecretAnnotationPrefix, UnitID(binding))
 }
 
 func (p *serviceBindingProjector) volumeName(binding *servicebindingv1beta1.ServiceBinding) string {
-	return fmt.Sprintf("%s%s", VolumePrefix, binding.UID)
+	return fmt.Sprintf("%s%s", VolumePrefix, UnitID(binding))
+}
+
+// bindingType returns the type defined by the binding, or the effective type resolved from the binding secret
+func (p *serviceBindingProjector) bindingType(binding *servicebindingv1beta1.ServiceBinding) string {
+	if binding.Spec.Type != "" || binding.Status.Binding == nil {
+		return binding.Spec.Type
+	}
+	return binding.Status.Binding.Type
+}
+
+// bindingProvider returns the provider defined by the binding, or the effective provider resolved from the binding
+// secret
+func (p *serviceBindingProjector) bindingProvider(binding *servicebindingv1beta1.ServiceBinding) string {
+	if binding.Spec.Provider != "" || binding.Status.Binding == nil {
+		return binding.Spec.Provider
+	}
+	return binding.Status.Binding.Provider
 }
 
 func (p *serviceBindingProjector) typeAnnotation(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) string {
 	key := p.typeAnnotationName(binding)
-	mpt.Annotations[key] = binding.Spec.Type
+	mpt.Annotations[key] = p.bindingType(binding)
 	return key
 }
 
 func (p *serviceBindingProjector) typeAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
-	return fmt.Sprintf("%s%s", TypeAnnotationPrefix, binding.UID)
+	return fmt.Sprintf("%s%s", TypeAnnotationPrefix, UnitID(binding))
 }
 
 func (p *serviceBindingProjector) providerAnnotation(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) string {
 	key := p.providerAnnotationName(binding)
-	mpt.Annotations[key] = binding.Spec.Provider
+	mpt.Annotations[key] = p.bindingProvider(binding)
 	return key
 }
 
 func (p *serviceBindingProjector) providerAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
-	return fmt.Sprintf("%s%s", ProviderAnnotationPrefix, binding.UID)
+	return fmt.Sprintf("%s%s", ProviderAnnotationPrefix, UnitID(binding))
+}
+
+func (p *serviceBindingProjector) hashAnnotation(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	if !binding.Spec.RolloutOnRotation || binding.Status.Binding == nil || binding.Status.Binding.Hash == "" {
+		return
+	}
+	mpt.Annotations[p.hashAnnotationName(binding)] = binding.Status.Binding.Hash
+}
+
+func (p *serviceBindingProjector) hashAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", HashAnnotationPrefix, UnitID(binding))
+}
+
+func (p *serviceBindingProjector) pathAnnotation(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) string {
+	mpt.Annotations[p.pathAnnotationName(binding)] = binding.Spec.Name
+	return binding.Spec.Name
+}
+
+func (p *serviceBindingProjector) pathAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", PathAnnotationPrefix, UnitID(binding))
 }
@@ -26,8 +26,10 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
 	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
 )
@@ -521,37 +523,30 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "project service binding env",
+			name:    "update service binding secret hash",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					UID: uid,
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
-					Name: bindingName,
-					Env: []servicebindingv1beta1.EnvMapping{
-						{
-							Name: "FOO",
-							Key:  "foo",
-						},
-						{
-							Name: "BAR",
-							Key:  "bar",
-						},
-					},
+					Name:              bindingName,
+					RolloutOnRotation: true,
 				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
 					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
 						Name: secretName,
+						Hash: "rotated-hash",
 					},
 				},
 			},
 			workload: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
-						Spec: corev1.PodSpec{
-							Containers: []corev1.Container{
-								{},
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/hash-26894874-4719-4802-8f43-8ceed127b4c2":   "original-hash",
 							},
 						},
 					},
@@ -563,6 +558,7 @@ func TestBinding(t *testing.T) {
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
 								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/hash-26894874-4719-4802-8f43-8ceed127b4c2":   "rotated-hash",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -584,43 +580,65 @@ func TestBinding(t *testing.T) {
 									},
 								},
 							},
-							Containers: []corev1.Container{
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "remove service binding secret hash",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+						Hash: "original-hash",
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/hash-26894874-4719-4802-8f43-8ceed127b4c2":   "original-hash",
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
 								{
-									Env: []corev1.EnvVar{
-										{
-											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/bindings",
-										},
-										{
-											Name: "BAR",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "bar",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
-										{
-											Name: "FOO",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "foo",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
 													},
 												},
 											},
 										},
 									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
-											ReadOnly:  true,
-											MountPath: "/bindings/my-binding",
-										},
-									},
 								},
 							},
 						},
@@ -629,7 +647,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "remove service binding env",
+			name:    "project service binding volume options",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -637,6 +655,11 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
 					Name: bindingName,
+					Volume: &servicebindingv1beta1.VolumeOptions{
+						MountPath:   "/etc/nginx/certs",
+						DefaultMode: pointer.Int32(0400),
+						ReadOnly:    pointer.Bool(false),
+					},
 				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
 					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
@@ -645,6 +668,17 @@ func TestBinding(t *testing.T) {
 				},
 			},
 			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
@@ -667,6 +701,7 @@ func TestBinding(t *testing.T) {
 													},
 												},
 											},
+											DefaultMode: pointer.Int32(0400),
 										},
 									},
 								},
@@ -678,34 +713,12 @@ func TestBinding(t *testing.T) {
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "BAR",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "bar",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
-										{
-											Name: "FOO",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "foo",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
 											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
-											ReadOnly:  true,
-											MountPath: "/bindings/my-binding",
+											ReadOnly:  false,
+											MountPath: "/etc/nginx/certs",
 										},
 									},
 								},
@@ -714,12 +727,49 @@ func TestBinding(t *testing.T) {
 					},
 				},
 			},
+		},
+		{
+			name:    "project service binding files",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Files: []servicebindingv1beta1.FileMapping{
+						{
+							Key:  "username",
+							Path: "credentials/user",
+						},
+						{
+							Key: "password",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
 			expected: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -732,7 +782,17 @@ func TestBinding(t *testing.T) {
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: secretName,
+															Name: "my-secret",
+														},
+														Items: []corev1.KeyToPath{
+															{
+																Key:  "username",
+																Path: "credentials/user",
+															},
+															{
+																Key:  "password",
+																Path: "password",
+															},
 														},
 													},
 												},
@@ -764,7 +824,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "update service binding env",
+			name:    "remove service binding files",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -772,16 +832,6 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
 					Name: bindingName,
-					Env: []servicebindingv1beta1.EnvMapping{
-						{
-							Name: "BLEEP",
-							Key:  "bleep",
-						},
-						{
-							Name: "BLOOP",
-							Key:  "bloop",
-						},
-					},
 				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
 					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
@@ -794,11 +844,14 @@ func TestBinding(t *testing.T) {
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
 							},
 						},
 						Spec: corev1.PodSpec{
 							Volumes: []corev1.Volume{
+								{
+									Name: "preexisting",
+								},
 								{
 									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 									VolumeSource: corev1.VolumeSource{
@@ -807,7 +860,13 @@ func TestBinding(t *testing.T) {
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: secretName,
+															Name: "my-secret",
+														},
+														Items: []corev1.KeyToPath{
+															{
+																Key:  "username",
+																Path: "credentials/user",
+															},
 														},
 													},
 												},
@@ -823,28 +882,6 @@ func TestBinding(t *testing.T) {
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "BAR",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "bar",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
-										{
-											Name: "FOO",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "foo",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -864,11 +901,14 @@ func TestBinding(t *testing.T) {
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
 							},
 						},
 						Spec: corev1.PodSpec{
 							Volumes: []corev1.Volume{
+								{
+									Name: "preexisting",
+								},
 								{
 									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 									VolumeSource: corev1.VolumeSource{
@@ -877,7 +917,7 @@ func TestBinding(t *testing.T) {
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: secretName,
+															Name: "my-secret",
 														},
 													},
 												},
@@ -893,28 +933,6 @@ func TestBinding(t *testing.T) {
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "BLEEP",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "bleep",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
-										{
-											Name: "BLOOP",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "bloop",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: secretName,
-													},
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -931,24 +949,22 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "project service binding type and provider for env and volume",
+			name:    "project service binding env",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					UID: uid,
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
-					Name:     bindingName,
-					Type:     "my-type",
-					Provider: "my-provider",
+					Name: bindingName,
 					Env: []servicebindingv1beta1.EnvMapping{
 						{
-							Name: "TYPE",
-							Key:  "type",
+							Name: "FOO",
+							Key:  "foo",
 						},
 						{
-							Name: "PROVIDER",
-							Key:  "provider",
+							Name: "BAR",
+							Key:  "bar",
 						},
 					},
 				},
@@ -974,9 +990,7 @@ func TestBinding(t *testing.T) {
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":   secretName,
-								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":     "my-type",
-								"projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2": "my-provider",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -993,30 +1007,6 @@ func TestBinding(t *testing.T) {
 														},
 													},
 												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "type",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
-																},
-															},
-														},
-													},
-												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "provider",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
-																},
-															},
-														},
-													},
-												},
 											},
 										},
 									},
@@ -1030,18 +1020,24 @@ func TestBinding(t *testing.T) {
 											Value: "/bindings",
 										},
 										{
-											Name: "PROVIDER",
+											Name: "BAR",
 											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "bar",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
 												},
 											},
 										},
 										{
-											Name: "TYPE",
+											Name: "FOO",
 											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
 												},
 											},
 										},
@@ -1061,7 +1057,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "update service binding type and provider",
+			name:    "project service binding env from secret keys",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1069,31 +1065,49 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
 					Name: bindingName,
+					Type: "mysql",
 					Env: []servicebindingv1beta1.EnvMapping{
 						{
-							Name: "TYPE",
-							Key:  "type",
-						},
-						{
-							Name: "PROVIDER",
-							Key:  "provider",
+							Name: "DB_HOST",
+							Key:  "hostname",
 						},
 					},
+					EnvFrom: &servicebindingv1beta1.EnvFromMapping{
+						Prefix: "DB_",
+					},
 				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
 					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
 						Name: secretName,
+						Keys: []string{"host", "password", "type", "user.name"},
 					},
 				},
 			},
 			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "PRESERVE",
+											Value: "me",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":   secretName,
-								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":     "my-type",
-								"projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2": "my-provider",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":   "mysql",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -1122,18 +1136,6 @@ func TestBinding(t *testing.T) {
 														},
 													},
 												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "provider",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
-																},
-															},
-														},
-													},
-												},
 											},
 										},
 									},
@@ -1142,12 +1144,38 @@ func TestBinding(t *testing.T) {
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
+										{
+											Name:  "PRESERVE",
+											Value: "me",
+										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
 										{
-											Name: "TYPE",
+											Name: "DB_HOST",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "hostname",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "password",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "DB_TYPE",
 											ValueFrom: &corev1.EnvVarSource{
 												FieldRef: &corev1.ObjectFieldSelector{
 													FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
@@ -1155,10 +1183,13 @@ func TestBinding(t *testing.T) {
 											},
 										},
 										{
-											Name: "PROVIDER",
+											Name: "DB_USER_NAME",
 											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "user.name",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
 												},
 											},
 										},
@@ -1176,12 +1207,58 @@ func TestBinding(t *testing.T) {
 					},
 				},
 			},
+		},
+		{
+			name:    "project service binding env from binding type profile",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Type: "postgresql",
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "PGHOST",
+							Key:  "hostname",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+						Env: []servicebindingv1beta1.EnvMapping{
+							{
+								Name: "PGHOST",
+								Key:  "host",
+							},
+							{
+								Name: "PGUSER",
+								Key:  "username",
+							},
+						},
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
 			expected: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
 								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":   "postgresql",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -1198,6 +1275,18 @@ func TestBinding(t *testing.T) {
 														},
 													},
 												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1211,24 +1300,24 @@ func TestBinding(t *testing.T) {
 											Value: "/bindings",
 										},
 										{
-											Name: "PROVIDER",
+											Name: "PGHOST",
 											ValueFrom: &corev1.EnvVarSource{
 												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "hostname",
 													LocalObjectReference: corev1.LocalObjectReference{
 														Name: secretName,
 													},
-													Key: "provider",
 												},
 											},
 										},
 										{
-											Name: "TYPE",
+											Name: "PGUSER",
 											ValueFrom: &corev1.EnvVarSource{
 												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "username",
 													LocalObjectReference: corev1.LocalObjectReference{
 														Name: secretName,
 													},
-													Key: "type",
 												},
 											},
 										},
@@ -1248,7 +1337,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "no binding if missing secret",
+			name:    "project service binding env template",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1256,51 +1345,17 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
 					Name: bindingName,
-				},
-			},
-			workload: &appsv1.Deployment{
-				Spec: appsv1.DeploymentSpec{
-					Template: corev1.PodTemplateSpec{
-						Spec: corev1.PodSpec{
-							Containers: []corev1.Container{
-								{},
-							},
-						},
-					},
-				},
-			},
-			expected: &appsv1.Deployment{
-				Spec: appsv1.DeploymentSpec{
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Annotations: map[string]string{},
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name:     "DATABASE_URL",
+							Template: "postgres://{{ .username }}:{{ .password }}@{{ .host }}/{{ .database }}",
 						},
-						Spec: corev1.PodSpec{
-							Volumes: []corev1.Volume{},
-							Containers: []corev1.Container{
-								{
-									Env:          []corev1.EnvVar{},
-									VolumeMounts: []corev1.VolumeMount{},
-								},
-							},
+						{
+							Name: "USERNAME",
+							Key:  "username",
 						},
 					},
 				},
-			},
-		},
-		{
-			name:    "only bind to allowed containers",
-			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
-			binding: &servicebindingv1beta1.ServiceBinding{
-				ObjectMeta: metav1.ObjectMeta{
-					UID: uid,
-				},
-				Spec: servicebindingv1beta1.ServiceBindingSpec{
-					Name: bindingName,
-					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
-						Containers: []string{"bind"},
-					},
-				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
 					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
 						Name: secretName,
@@ -1312,15 +1367,7 @@ func TestBinding(t *testing.T) {
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
-								{
-									Name: "bind",
-								},
-								{
-									Name: "skip",
-								},
-								{
-									Name: "",
-								},
+								{},
 							},
 						},
 					},
@@ -1355,12 +1402,33 @@ func TestBinding(t *testing.T) {
 							},
 							Containers: []corev1.Container{
 								{
-									Name: "bind",
 									Env: []corev1.EnvVar{
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
+										{
+											Name: "DATABASE_URL",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "DATABASE_URL",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "servicebinding-env-26894874-4719-4802-8f43-8ceed127b4c2",
+													},
+												},
+											},
+										},
+										{
+											Name: "USERNAME",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "username",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -1370,16 +1438,6 @@ func TestBinding(t *testing.T) {
 										},
 									},
 								},
-								{
-									Name:         "skip",
-									Env:          []corev1.EnvVar{},
-									VolumeMounts: []corev1.VolumeMount{},
-								},
-								{
-									Name:         "",
-									Env:          []corev1.EnvVar{},
-									VolumeMounts: []corev1.VolumeMount{},
-								},
 							},
 						},
 					},
@@ -1387,7 +1445,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "preserve other bindings",
+			name:    "remove service binding env template",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1395,7 +1453,1155 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1beta1.ServiceBindingSpec{
 					Name: bindingName,
-					Env: []servicebindingv1beta1.EnvMapping{
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DATABASE_URL",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "DATABASE_URL",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "servicebinding-env-26894874-4719-4802-8f43-8ceed127b4c2",
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "remove service binding env from secret keys",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+						Keys: []string{"password", "username"},
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "password",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "USERNAME",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "username",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "remove service binding env",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "BAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "bar",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "update service binding env",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "BLEEP",
+							Key:  "bleep",
+						},
+						{
+							Name: "BLOOP",
+							Key:  "bloop",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "BAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "bar",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "BLEEP",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "bleep",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "BLOOP",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "bloop",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "project service binding type and provider for env and volume",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name:     bindingName,
+					Type:     "my-type",
+					Provider: "my-provider",
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "TYPE",
+							Key:  "type",
+						},
+						{
+							Name: "PROVIDER",
+							Key:  "provider",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":   secretName,
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":     "my-type",
+								"projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2": "my-provider",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "provider",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "project effective service binding type and provider from status",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "TYPE",
+							Key:  "type",
+						},
+						{
+							Name: "PROVIDER",
+							Key:  "provider",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name:     secretName,
+						Type:     "my-type",
+						Provider: "my-provider",
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":   secretName,
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":     "my-type",
+								"projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2": "my-provider",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "provider",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "update service binding type and provider",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "TYPE",
+							Key:  "type",
+						},
+						{
+							Name: "PROVIDER",
+							Key:  "provider",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":   secretName,
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":     "my-type",
+								"projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2": "my-provider",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "provider",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-26894874-4719-4802-8f43-8ceed127b4c2']",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "provider",
+												},
+											},
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "type",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "no binding if missing secret",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{},
+							Containers: []corev1.Container{
+								{
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "only bind to allowed containers",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+						Containers: []string{"bind"},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "bind",
+								},
+								{
+									Name: "skip",
+								},
+								{
+									Name: "",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "bind",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+								{
+									Name:         "skip",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+								{
+									Name:         "",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "preserve other bindings",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
 						{
 							Name: "FOO",
 							Key:  "foo",
@@ -1414,8 +2620,375 @@ func TestBinding(t *testing.T) {
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
 								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
-								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
-								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
+								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "preexisting",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "z_existing",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-3",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-22222222-2222-2222-2222-222222222222",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-2",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-1",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
+																},
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "provider",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "PREEXISTING",
+											Value: "env",
+										},
+										{
+											Name:  "Z_EXISTING",
+											Value: "env",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
+												},
+											},
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "preexisting",
+											MountPath: "/var/mount",
+										},
+										{
+											Name:      "z_existing",
+											MountPath: "/var/mount",
+										},
+										{
+											Name:      "servicebinding-33333333-3333-3333-3333-333333333333",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-3",
+										},
+										{
+											Name:      "servicebinding-22222222-2222-2222-2222-222222222222",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-2",
+										},
+										{
+											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-1",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
+								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "preexisting",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "z_existing",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-1",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
+																},
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "provider",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-22222222-2222-2222-2222-222222222222",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-2",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "secret-3",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "PREEXISTING",
+											Value: "env",
+										},
+										{
+											Name:  "Z_EXISTING",
+											Value: "env",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-secret",
+													},
+												},
+											},
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
+												},
+											},
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "preexisting",
+											MountPath: "/var/mount",
+										},
+										{
+											Name:      "z_existing",
+											MountPath: "/var/mount",
+										},
+										{
+											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-1",
+										},
+										{
+											Name:      "servicebinding-22222222-2222-2222-2222-222222222222",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-2",
+										},
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+										{
+											Name:      "servicebinding-33333333-3333-3333-3333-333333333333",
+											ReadOnly:  true,
+											MountPath: "/bindings/binding-3",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "apply binding should be idempotent",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -1427,20 +3000,14 @@ func TestBinding(t *testing.T) {
 									},
 								},
 								{
-									Name: "z_existing",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
-								{
-									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
+									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-3",
+															Name: "secret-1",
 														},
 													},
 												},
@@ -1449,14 +3016,14 @@ func TestBinding(t *testing.T) {
 									},
 								},
 								{
-									Name: "servicebinding-22222222-2222-2222-2222-222222222222",
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-2",
+															Name: "my-secret",
 														},
 													},
 												},
@@ -1465,43 +3032,9 @@ func TestBinding(t *testing.T) {
 									},
 								},
 								{
-									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
+									Name: "preexisting2",
 									VolumeSource: corev1.VolumeSource{
-										Projected: &corev1.ProjectedVolumeSource{
-											Sources: []corev1.VolumeProjection{
-												{
-													Secret: &corev1.SecretProjection{
-														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-1",
-														},
-													},
-												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "type",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
-																},
-															},
-														},
-													},
-												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "provider",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
-																},
-															},
-														},
-													},
-												},
-											},
-										},
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
 									},
 								},
 							},
@@ -1512,30 +3045,10 @@ func TestBinding(t *testing.T) {
 											Name:  "PREEXISTING",
 											Value: "env",
 										},
-										{
-											Name:  "Z_EXISTING",
-											Value: "env",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "TYPE",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
-												},
-											},
-										},
-										{
-											Name: "PROVIDER",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -1543,23 +3056,18 @@ func TestBinding(t *testing.T) {
 											MountPath: "/var/mount",
 										},
 										{
-											Name:      "z_existing",
-											MountPath: "/var/mount",
-										},
-										{
-											Name:      "servicebinding-33333333-3333-3333-3333-333333333333",
+											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
 											ReadOnly:  true,
-											MountPath: "/bindings/binding-3",
+											MountPath: "/bindings/binding-1",
 										},
 										{
-											Name:      "servicebinding-22222222-2222-2222-2222-222222222222",
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 											ReadOnly:  true,
-											MountPath: "/bindings/binding-2",
+											MountPath: "/bindings/my-binding",
 										},
 										{
-											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
-											ReadOnly:  true,
-											MountPath: "/bindings/binding-1",
+											Name:      "preexisting2",
+											MountPath: "/var/mount",
 										},
 									},
 								},
@@ -1574,9 +3082,7 @@ func TestBinding(t *testing.T) {
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
 								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
-								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
-								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
 							},
 						},
 						Spec: corev1.PodSpec{
@@ -1588,7 +3094,7 @@ func TestBinding(t *testing.T) {
 									},
 								},
 								{
-									Name: "z_existing",
+									Name: "preexisting2",
 									VolumeSource: corev1.VolumeSource{
 										EmptyDir: &corev1.EmptyDirVolumeSource{},
 									},
@@ -1605,46 +3111,6 @@ func TestBinding(t *testing.T) {
 														},
 													},
 												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "type",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
-																},
-															},
-														},
-													},
-												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																Path: "provider",
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								{
-									Name: "servicebinding-22222222-2222-2222-2222-222222222222",
-									VolumeSource: corev1.VolumeSource{
-										Projected: &corev1.ProjectedVolumeSource{
-											Sources: []corev1.VolumeProjection{
-												{
-													Secret: &corev1.SecretProjection{
-														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-2",
-														},
-													},
-												},
 											},
 										},
 									},
@@ -1657,23 +3123,7 @@ func TestBinding(t *testing.T) {
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: secretName,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								{
-									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
-									VolumeSource: corev1.VolumeSource{
-										Projected: &corev1.ProjectedVolumeSource{
-											Sources: []corev1.VolumeProjection{
-												{
-													Secret: &corev1.SecretProjection{
-														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-3",
+															Name: "my-secret",
 														},
 													},
 												},
@@ -1682,7 +3132,6 @@ func TestBinding(t *testing.T) {
 									},
 								},
 							},
-
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
@@ -1690,41 +3139,10 @@ func TestBinding(t *testing.T) {
 											Name:  "PREEXISTING",
 											Value: "env",
 										},
-										{
-											Name:  "Z_EXISTING",
-											Value: "env",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "FOO",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													Key: "foo",
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: "my-secret",
-													},
-												},
-											},
-										},
-										{
-											Name: "PROVIDER",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/provider-11111111-1111-1111-1111-111111111111']",
-												},
-											},
-										},
-										{
-											Name: "TYPE",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['projector.servicebinding.io/type-11111111-1111-1111-1111-111111111111']",
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -1732,7 +3150,7 @@ func TestBinding(t *testing.T) {
 											MountPath: "/var/mount",
 										},
 										{
-											Name:      "z_existing",
+											Name:      "preexisting2",
 											MountPath: "/var/mount",
 										},
 										{
@@ -1740,20 +3158,174 @@ func TestBinding(t *testing.T) {
 											ReadOnly:  true,
 											MountPath: "/bindings/binding-1",
 										},
-										{
-											Name:      "servicebinding-22222222-2222-2222-2222-222222222222",
-											ReadOnly:  true,
-											MountPath: "/bindings/binding-2",
-										},
 										{
 											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 											ReadOnly:  true,
-											MountPath: "/bindings/my-binding",
+											MountPath: "/bindings",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple templates",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Templates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Annotations: ".spec.driver.template.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{
+								Path: ".spec.driver.template.spec.containers[*]",
+								Name: ".name",
+							},
+						},
+						Volumes: ".spec.driver.template.spec.volumes",
+					},
+					{
+						Annotations: ".spec.executor.template.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{
+								Path: ".spec.executor.template.spec.containers[*]",
+								Name: ".name",
+							},
+						},
+						Volumes: ".spec.executor.template.spec.volumes",
+					},
+				},
+			}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "DriverExecutorApp",
+					"spec": map[string]interface{}{
+						"driver": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name": "driver",
+										},
+									},
+								},
+							},
+						},
+						"executor": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name": "executor",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "DriverExecutorApp",
+					"spec": map[string]interface{}{
+						"driver": map[string]interface{}{
+							"template": map[string]interface{}{
+								"metadata": map[string]interface{}{
+									"annotations": map[string]interface{}{
+										"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+									},
+								},
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name": "driver",
+											"env": []interface{}{
+												map[string]interface{}{
+													"name":  "SERVICE_BINDING_ROOT",
+													"value": "/bindings",
+												},
+											},
+											"volumeMounts": []interface{}{
+												map[string]interface{}{
+													"name":      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+													"mountPath": "/bindings/my-binding",
+													"readOnly":  true,
+												},
+											},
+										},
+									},
+									"volumes": []interface{}{
+										map[string]interface{}{
+											"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											"projected": map[string]interface{}{
+												"sources": []interface{}{
+													map[string]interface{}{
+														"secret": map[string]interface{}{
+															"name": "my-secret",
+														},
+													},
+												},
+											},
 										},
-										{
-											Name:      "servicebinding-33333333-3333-3333-3333-333333333333",
-											ReadOnly:  true,
-											MountPath: "/bindings/binding-3",
+									},
+								},
+							},
+						},
+						"executor": map[string]interface{}{
+							"template": map[string]interface{}{
+								"metadata": map[string]interface{}{
+									"annotations": map[string]interface{}{
+										"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+									},
+								},
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name": "executor",
+											"env": []interface{}{
+												map[string]interface{}{
+													"name":  "SERVICE_BINDING_ROOT",
+													"value": "/bindings",
+												},
+											},
+											"volumeMounts": []interface{}{
+												map[string]interface{}{
+													"name":      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+													"mountPath": "/bindings/my-binding",
+													"readOnly":  true,
+												},
+											},
+										},
+									},
+									"volumes": []interface{}{
+										map[string]interface{}{
+											"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											"projected": map[string]interface{}{
+												"sources": []interface{}{
+													map[string]interface{}{
+														"secret": map[string]interface{}{
+															"name": "my-secret",
+														},
+													},
+												},
+											},
 										},
 									},
 								},
@@ -1764,60 +3336,115 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "apply binding should be idempotent",
+			name:    "project each service",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
 			binding: &servicebindingv1beta1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					UID: uid,
 				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Services: []servicebindingv1beta1.ServiceBindingServiceEntry{
+						{
+							Name: "db",
+							Type: "postgresql",
+							Env: []servicebindingv1beta1.EnvMapping{
+								{
+									Name: "DB_HOST",
+									Key:  "host",
+								},
+							},
+						},
+						{
+							Name: "cache",
+						},
+					},
+				},
 				Status: servicebindingv1beta1.ServiceBindingStatus{
-					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
-						Name: secretName,
+					Services: []servicebindingv1beta1.ServiceBindingServiceStatus{
+						{
+							Name: "db",
+							Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+								Name: "my-db-secret",
+							},
+						},
+						{
+							// the cache is not yet available
+							Name: "cache",
+						},
 					},
 				},
 			},
 			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1": "my-db-secret",
+								"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1":   "postgresql",
 							},
 						},
 						Spec: corev1.PodSpec{
-							Volumes: []corev1.Volume{
-								{
-									Name: "preexisting",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
+							Containers: []corev1.Container{
 								{
-									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
-									VolumeSource: corev1.VolumeSource{
-										Projected: &corev1.ProjectedVolumeSource{
-											Sources: []corev1.VolumeProjection{
-												{
-													Secret: &corev1.SecretProjection{
-														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-1",
-														},
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DB_HOST",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-db-secret",
 													},
+													Key: "host",
 												},
 											},
 										},
 									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
+											ReadOnly:  true,
+											MountPath: "/bindings/db",
+										},
+									},
 								},
+							},
+							Volumes: []corev1.Volume{
 								{
-									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "my-secret",
+															Name: "my-db-secret",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																Path: "type",
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1']",
+																},
+															},
 														},
 													},
 												},
@@ -1825,83 +3452,90 @@ func TestBinding(t *testing.T) {
 										},
 									},
 								},
-								{
-									Name: "preexisting2",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
 							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unproject services no longer bound",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Services: []servicebindingv1beta1.ServiceBindingServiceEntry{
+						{
+							Name: "cache",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Services: []servicebindingv1beta1.ServiceBindingServiceStatus{
+						{
+							Name: "cache",
+							Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+								Name: "my-cache-secret",
+							},
+						},
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":          secretName,
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1": "my-db-secret",
+							},
+						},
+						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
-										{
-											Name:  "PREEXISTING",
-											Value: "env",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      "preexisting",
-											MountPath: "/var/mount",
-										},
 										{
-											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
-											ReadOnly:  true,
-											MountPath: "/bindings/binding-1",
+											Name: "DB_HOST",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-db-secret",
+													},
+													Key: "host",
+												},
+											},
 										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
 										{
 											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 											ReadOnly:  true,
 											MountPath: "/bindings/my-binding",
 										},
 										{
-											Name:      "preexisting2",
-											MountPath: "/var/mount",
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
+											ReadOnly:  true,
+											MountPath: "/bindings/db",
 										},
 									},
 								},
 							},
-						},
-					},
-				},
-			},
-			expected: &appsv1.Deployment{
-				Spec: appsv1.DeploymentSpec{
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
-								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
-							},
-						},
-						Spec: corev1.PodSpec{
 							Volumes: []corev1.Volume{
 								{
-									Name: "preexisting",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
-								{
-									Name: "preexisting2",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
-								{
-									Name: "servicebinding-11111111-1111-1111-1111-111111111111",
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "secret-1",
+															Name: secretName,
 														},
 													},
 												},
@@ -1910,14 +3544,14 @@ func TestBinding(t *testing.T) {
 									},
 								},
 								{
-									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "my-secret",
+															Name: "my-db-secret",
 														},
 													},
 												},
@@ -1926,13 +3560,22 @@ func TestBinding(t *testing.T) {
 									},
 								},
 							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2-5e1ecee0": "my-cache-secret",
+							},
+						},
+						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
-										{
-											Name:  "PREEXISTING",
-											Value: "env",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
@@ -1940,22 +3583,27 @@ func TestBinding(t *testing.T) {
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
-											Name:      "preexisting",
-											MountPath: "/var/mount",
-										},
-										{
-											Name:      "preexisting2",
-											MountPath: "/var/mount",
-										},
-										{
-											Name:      "servicebinding-11111111-1111-1111-1111-111111111111",
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-5e1ecee0",
 											ReadOnly:  true,
-											MountPath: "/bindings/binding-1",
+											MountPath: "/bindings/cache",
 										},
-										{
-											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
-											ReadOnly:  true,
-											MountPath: "/bindings",
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-5e1ecee0",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-cache-secret",
+														},
+													},
+												},
+											},
 										},
 									},
 								},
@@ -2017,3 +3665,43 @@ type BadMarshalJSON struct {
 
 func (r *BadMarshalJSON) MarshalJSON() ([]byte, error)   { return nil, fmt.Errorf("bad json marshal") }
 func (r *BadMarshalJSON) DeepCopyObject() runtime.Object { return r }
+
+func TestEnvVarName(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		key      string
+		expected string
+	}{
+		{
+			name:     "upper case key",
+			key:      "username",
+			expected: "USERNAME",
+		},
+		{
+			name:     "prefixed key",
+			prefix:   "DB_",
+			key:      "host",
+			expected: "DB_HOST",
+		},
+		{
+			name:     "invalid characters",
+			prefix:   "db_",
+			key:      "tls.ca-cert",
+			expected: "DB_TLS_CA_CERT",
+		},
+		{
+			name:     "leading digit",
+			key:      "9lives",
+			expected: "_9LIVES",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, envVarName(c.prefix, c.key)); diff != "" {
+				t.Errorf("envVarName() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
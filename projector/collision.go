@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// CollisionError is returned when projecting a service binding would define a volume mount path or environment
+// variable that is already defined within a container, either by another service binding or by the workload itself.
+// The workload is not modified.
+type CollisionError struct {
+	// Kind is the kind of item that collides, either a volume mount or an env var
+	Kind ChangeKind
+	// Container is the name of the container. Containers without a name are identified by their index, like `[0]`.
+	Container string
+	// Name is the mount path of the volume mount or the name of the env var
+	Name string
+	// BindingUID is the uid of the service binding that projected the existing item, suffixed for a service of a binding
+	// with services, see UnitID. Empty when the existing item is defined by the workload.
+	BindingUID types.UID
+}
+
+func (e *CollisionError) Error() string {
+	if e.BindingUID == "" {
+		return fmt.Sprintf("%s %q in container %q collides with an existing %s defined by the workload", e.Kind, e.Name, e.Container, e.Kind)
+	}
+	return fmt.Sprintf("%s %q in container %q collides with an existing %s projected by service binding with uid %q", e.Kind, e.Name, e.Container, e.Kind, e.BindingUID)
+}
+
+func (p *serviceBindingProjector) checkVolumeMountCollision(mc *metaContainer, container string, mountPath string) error {
+	for _, m := range mc.VolumeMounts {
+		if m.MountPath != mountPath {
+			continue
+		}
+		err := &CollisionError{
+			Kind:      ChangeKindVolumeMount,
+			Container: container,
+			Name:      mountPath,
+		}
+		if strings.HasPrefix(m.Name, VolumePrefix) && m.Name != ConsolidatedVolumeName {
+			err.BindingUID = types.UID(strings.TrimPrefix(m.Name, VolumePrefix))
+		}
+		return err
+	}
+	return nil
+}
+
+// checkConsolidatedCollision returns an error when the mount path is the directory of another service binding within
+// the consolidated volume mounted into the container.
+func (p *serviceBindingProjector) checkConsolidatedCollision(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, container string, mountPath string) error {
+	root := ""
+	for _, m := range mc.VolumeMounts {
+		if m.Name == ConsolidatedVolumeName {
+			root = m.MountPath
+		}
+	}
+	if root == "" {
+		return nil
+	}
+	for k, v := range mpt.Annotations {
+		if !strings.HasPrefix(k, PathAnnotationPrefix) {
+			continue
+		}
+		uid := types.UID(strings.TrimPrefix(k, PathAnnotationPrefix))
+		if uid == UnitID(binding) || path.Join(root, v) != mountPath {
+			continue
+		}
+		return &CollisionError{
+			Kind:       ChangeKindVolumeMount,
+			Container:  container,
+			Name:       mountPath,
+			BindingUID: uid,
+		}
+	}
+	return nil
+}
+
+func (p *serviceBindingProjector) checkEnvCollision(mpt *metaPodTemplate, mc *metaContainer, container string, name string) error {
+	for _, e := range mc.Env {
+		if e.Name != name {
+			continue
+		}
+		return &CollisionError{
+			Kind:       ChangeKindEnv,
+			Container:  container,
+			Name:       name,
+			BindingUID: p.projectedEnvBindingUID(mpt, e),
+		}
+	}
+	return nil
+}
+
+// projectedEnvBindingUID returns the uid of the service binding that projected the env var, or an empty string if the
+// env var was not projected.
+func (p *serviceBindingProjector) projectedEnvBindingUID(mpt *metaPodTemplate, e corev1.EnvVar) types.UID {
+	if e.ValueFrom == nil {
+		return ""
+	}
+	if ref := e.ValueFrom.SecretKeyRef; ref != nil {
+		if strings.HasPrefix(ref.Name, EnvSecretPrefix) {
+			return types.UID(strings.TrimPrefix(ref.Name, EnvSecretPrefix))
+		}
+		for k, v := range mpt.Annotations {
+			if strings.HasPrefix(k, SecretAnnotationPrefix) && v == ref.Name {
+				return types.UID(strings.TrimPrefix(k, SecretAnnotationPrefix))
+			}
+		}
+	}
+	if ref := e.ValueFrom.FieldRef; ref != nil {
+		for _, prefix := range []string{TypeAnnotationPrefix, ProviderAnnotationPrefix} {
+			fieldPathPrefix := fmt.Sprintf("metadata.annotations['%s", prefix)
+			if strings.HasPrefix(ref.FieldPath, fieldPathPrefix) {
+				return types.UID(strings.TrimSuffix(strings.TrimPrefix(ref.FieldPath, fieldPathPrefix), "']"))
+			}
+		}
+	}
+	return ""
+}
+
+func (p *serviceBindingProjector) containerName(mc *metaContainer, i int) string {
+	if mc.Name != nil && *mc.Name != "" {
+		return *mc.Name
+	}
+	return fmt.Sprintf("[%d]", i)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+func TestBindingCollisions(t *testing.T) {
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	otherUID := types.UID("5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d")
+
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "USERNAME",
+					Key:  "username",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	workload := func(annotations map[string]string, container corev1.Container) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: annotations,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{container},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		workload    *appsv1.Deployment
+		expectedErr *CollisionError
+	}{
+		{
+			name: "no collision",
+			workload: workload(nil, corev1.Container{
+				Name: "hello",
+				Env: []corev1.EnvVar{
+					{
+						Name:  "PASSWORD",
+						Value: "secret",
+					},
+				},
+			}),
+		},
+		{
+			name: "reproject same binding",
+			workload: workload(map[string]string{
+				"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+			}, corev1.Container{
+				Name: "hello",
+				Env: []corev1.EnvVar{
+					{
+						Name:  "SERVICE_BINDING_ROOT",
+						Value: "/bindings",
+					},
+					{
+						Name: "USERNAME",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "my-secret",
+								},
+								Key: "username",
+							},
+						},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+						ReadOnly:  true,
+						MountPath: "/bindings/my-binding",
+					},
+				},
+			}),
+		},
+		{
+			name: "mount path projected by another binding",
+			workload: workload(map[string]string{
+				"projector.servicebinding.io/secret-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d": "other-secret",
+			}, corev1.Container{
+				Name: "hello",
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "servicebinding-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d",
+						ReadOnly:  true,
+						MountPath: "/bindings/my-binding",
+					},
+				},
+			}),
+			expectedErr: &CollisionError{
+				Kind:       ChangeKindVolumeMount,
+				Container:  "hello",
+				Name:       "/bindings/my-binding",
+				BindingUID: otherUID,
+			},
+		},
+		{
+			name: "mount path defined by the workload",
+			workload: workload(nil, corev1.Container{
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "config",
+						MountPath: "/bindings/my-binding",
+					},
+				},
+			}),
+			expectedErr: &CollisionError{
+				Kind:      ChangeKindVolumeMount,
+				Container: "[0]",
+				Name:      "/bindings/my-binding",
+			},
+		},
+		{
+			name: "env var projected by another binding",
+			workload: workload(map[string]string{
+				"projector.servicebinding.io/secret-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d": "other-secret",
+			}, corev1.Container{
+				Name: "hello",
+				Env: []corev1.EnvVar{
+					{
+						Name: "USERNAME",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "other-secret",
+								},
+								Key: "username",
+							},
+						},
+					},
+				},
+			}),
+			expectedErr: &CollisionError{
+				Kind:       ChangeKindEnv,
+				Container:  "hello",
+				Name:       "USERNAME",
+				BindingUID: otherUID,
+			},
+		},
+		{
+			name: "env var type projected by another binding",
+			workload: workload(map[string]string{
+				"projector.servicebinding.io/type-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d": "mysql",
+			}, corev1.Container{
+				Name: "hello",
+				Env: []corev1.EnvVar{
+					{
+						Name: "USERNAME",
+						ValueFrom: &corev1.EnvVarSource{
+							FieldRef: &corev1.ObjectFieldSelector{
+								FieldPath: "metadata.annotations['projector.servicebinding.io/type-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d']",
+							},
+						},
+					},
+				},
+			}),
+			expectedErr: &CollisionError{
+				Kind:       ChangeKindEnv,
+				Container:  "hello",
+				Name:       "USERNAME",
+				BindingUID: otherUID,
+			},
+		},
+		{
+			name: "env var defined by the workload",
+			workload: workload(nil, corev1.Container{
+				Name: "hello",
+				Env: []corev1.EnvVar{
+					{
+						Name:  "USERNAME",
+						Value: "admin",
+					},
+				},
+			}),
+			expectedErr: &CollisionError{
+				Kind:      ChangeKindEnv,
+				Container: "hello",
+				Name:      "USERNAME",
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			actual := c.workload.DeepCopy()
+			err := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})).Project(ctx, binding, actual)
+
+			if c.expectedErr == nil {
+				if err != nil {
+					t.Errorf("Project() unexpected err: %v", err)
+				}
+				return
+			}
+			var collisionErr *CollisionError
+			if !errors.As(err, &collisionErr) {
+				t.Fatalf("Project() expected CollisionError, got: %v", err)
+			}
+			if diff := cmp.Diff(c.expectedErr, collisionErr); diff != "" {
+				t.Errorf("Project() err (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.workload, actual); diff != "" {
+				t.Errorf("Project() mutated workload (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCollisionError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *CollisionError
+		expected string
+	}{
+		{
+			name: "another binding",
+			err: &CollisionError{
+				Kind:       ChangeKindVolumeMount,
+				Container:  "hello",
+				Name:       "/bindings/my-binding",
+				BindingUID: "5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d",
+			},
+			expected: `volume mount "/bindings/my-binding" in container "hello" collides with an existing volume mount projected by service binding with uid "5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d"`,
+		},
+		{
+			name: "workload",
+			err: &CollisionError{
+				Kind:      ChangeKindEnv,
+				Container: "hello",
+				Name:      "USERNAME",
+			},
+			expected: `env var "USERNAME" in container "hello" collides with an existing env var defined by the workload`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.err.Error()); diff != "" {
+				t.Errorf("Error() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
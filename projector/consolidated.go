@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// A consolidated binding is projected into a single projected volume that is shared with every other consolidated
+// binding for the workload. The binding's sources are distinguished by the binding name directory their items are
+// projected into, which is recorded in a path annotation so the sources can be found again after the binding is
+// renamed.
+
+func (p *serviceBindingProjector) isConsolidated(binding *servicebindingv1beta1.ServiceBinding) bool {
+	return binding.Spec.Volume != nil && binding.Spec.Volume.Consolidated
+}
+
+func (p *serviceBindingProjector) projectConsolidatedVolume(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	sources := p.consolidatedSources(binding, mpt, binding.Spec.Name)
+	if len(sources) == 0 {
+		// nothing to project, the secret keys are not yet known
+		return
+	}
+	p.pathAnnotation(binding, mpt)
+
+	volume := p.consolidatedVolume(mpt)
+	if volume == nil {
+		mpt.Volumes = append(mpt.Volumes, corev1.Volume{
+			Name: ConsolidatedVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{},
+			},
+		})
+		p.sortVolumes(mpt)
+		volume = p.consolidatedVolume(mpt)
+	}
+	volume.Projected.Sources = append(volume.Projected.Sources, sources...)
+
+	// sort sources by binding directory, the secret before the downward api
+	sort.SliceStable(volume.Projected.Sources, func(i, j int) bool {
+		ii := volume.Projected.Sources[i]
+		jj := volume.Projected.Sources[j]
+		id := p.consolidatedSourceDirectory(ii)
+		jd := p.consolidatedSourceDirectory(jj)
+		if id != jd {
+			return id < jd
+		}
+		return ii.Secret != nil && jj.Secret == nil
+	})
+}
+
+func (p *serviceBindingProjector) consolidatedSources(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, dir string) []corev1.VolumeProjection {
+	mode := func() *int32 {
+		if binding.Spec.Volume.DefaultMode == nil {
+			return nil
+		}
+		// the volume is shared, the mode is set on each item instead
+		m := *binding.Spec.Volume.DefaultMode
+		return &m
+	}
+	sources := []corev1.VolumeProjection{}
+
+	secretItems := p.secretItems(binding)
+	if secretItems == nil && binding.Status.Binding != nil {
+		for _, key := range binding.Status.Binding.Keys {
+			secretItems = append(secretItems, corev1.KeyToPath{Key: key, Path: key})
+		}
+	}
+	// a secret projection without items would project every entry into the root of the volume
+	if len(secretItems) != 0 {
+		for i := range secretItems {
+			secretItems[i].Path = path.Join(dir, secretItems[i].Path)
+			secretItems[i].Mode = mode()
+		}
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: p.secretAnnotation(binding, mpt),
+				},
+				Items: secretItems,
+			},
+		})
+	}
+
+	downwardAPIItems := []corev1.DownwardAPIVolumeFile{}
+	if p.bindingType(binding) != "" {
+		downwardAPIItems = append(downwardAPIItems, corev1.DownwardAPIVolumeFile{
+			Path: path.Join(dir, "type"),
+			FieldRef: &corev1.ObjectFieldSelector{
+				FieldPath: fmt.Sprintf("metadata.annotations['%s']", p.typeAnnotation(binding, mpt)),
+			},
+			Mode: mode(),
+		})
+	}
+	if p.bindingProvider(binding) != "" {
+		downwardAPIItems = append(downwardAPIItems, corev1.DownwardAPIVolumeFile{
+			Path: path.Join(dir, "provider"),
+			FieldRef: &corev1.ObjectFieldSelector{
+				FieldPath: fmt.Sprintf("metadata.annotations['%s']", p.providerAnnotation(binding, mpt)),
+			},
+			Mode: mode(),
+		})
+	}
+	if len(downwardAPIItems) != 0 {
+		sources = append(sources, corev1.VolumeProjection{
+			DownwardAPI: &corev1.DownwardAPIProjection{
+				Items: downwardAPIItems,
+			},
+		})
+	}
+
+	return sources
+}
+
+func (p *serviceBindingProjector) consolidatedVolume(mpt *metaPodTemplate) *corev1.Volume {
+	for i := range mpt.Volumes {
+		if mpt.Volumes[i].Name == ConsolidatedVolumeName && mpt.Volumes[i].Projected != nil {
+			return &mpt.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// consolidatedSourceDirectory returns the binding directory the source's items are projected into
+func (p *serviceBindingProjector) consolidatedSourceDirectory(source corev1.VolumeProjection) string {
+	paths := p.consolidatedSourcePaths(source)
+	if len(paths) == 0 {
+		return ""
+	}
+	return strings.SplitN(paths[0], "/", 2)[0]
+}
+
+func (p *serviceBindingProjector) consolidatedSourcePaths(source corev1.VolumeProjection) []string {
+	paths := []string{}
+	if source.Secret != nil {
+		for _, item := range source.Secret.Items {
+			paths = append(paths, item.Path)
+		}
+	}
+	if source.DownwardAPI != nil {
+		for _, item := range source.DownwardAPI.Items {
+			paths = append(paths, item.Path)
+		}
+	}
+	return paths
+}
+
+func (p *serviceBindingProjector) unprojectConsolidatedVolume(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	dir, ok := mpt.Annotations[p.pathAnnotationName(binding)]
+	if !ok {
+		return
+	}
+	volume := p.consolidatedVolume(mpt)
+	if volume == nil {
+		return
+	}
+
+	// remove only the sources projected into the binding's directory
+	sources := []corev1.VolumeProjection{}
+	for _, source := range volume.Projected.Sources {
+		owned := false
+		for _, item := range p.consolidatedSourcePaths(source) {
+			owned = strings.HasPrefix(item, dir+"/")
+			if !owned {
+				break
+			}
+		}
+		if !owned {
+			sources = append(sources, source)
+		}
+	}
+	volume.Projected.Sources = sources
+	if len(sources) != 0 {
+		return
+	}
+
+	// the last consolidated binding was removed
+	volumes := []corev1.Volume{}
+	for _, v := range mpt.Volumes {
+		if v.Name != ConsolidatedVolumeName {
+			volumes = append(volumes, v)
+		}
+	}
+	mpt.Volumes = volumes
+	for i := range mpt.Containers {
+		mc := &mpt.Containers[i]
+		mounts := []corev1.VolumeMount{}
+		for _, m := range mc.VolumeMounts {
+			if m.Name != ConsolidatedVolumeName {
+				mounts = append(mounts, m)
+			}
+		}
+		mc.VolumeMounts = mounts
+	}
+}
+
+func (p *serviceBindingProjector) projectConsolidatedVolumeMount(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, container string) error {
+	if p.consolidatedVolume(mpt) == nil {
+		// nothing was projected
+		return nil
+	}
+	root := p.serviceBindingRoot(mc)
+	dir := path.Join(root, binding.Spec.Name)
+	if err := p.checkVolumeMountCollision(mc, container, dir); err != nil {
+		return err
+	}
+	if err := p.checkConsolidatedCollision(binding, mpt, mc, container, dir); err != nil {
+		return err
+	}
+
+	for _, m := range mc.VolumeMounts {
+		if m.Name == ConsolidatedVolumeName {
+			// mounted by another consolidated binding
+			return nil
+		}
+	}
+	if err := p.checkVolumeMountCollision(mc, container, root); err != nil {
+		return err
+	}
+	mc.VolumeMounts = append(mc.VolumeMounts, corev1.VolumeMount{
+		Name:      ConsolidatedVolumeName,
+		ReadOnly:  true,
+		MountPath: root,
+	})
+	p.sortVolumeMounts(mc)
+
+	return nil
+}
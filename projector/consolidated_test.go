@@ -0,0 +1,370 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+func TestConsolidatedVolume(t *testing.T) {
+	uid1 := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	uid2 := types.UID("5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d")
+
+	binding1 := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid1,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "db",
+			Type: "mysql",
+			Volume: &servicebindingv1beta1.VolumeOptions{
+				DefaultMode:  pointer.Int32(0400),
+				Consolidated: true,
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "db-secret",
+				Keys: []string{"password", "username"},
+			},
+		},
+	}
+	binding2 := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid2,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "cache",
+			Files: []servicebindingv1beta1.FileMapping{
+				{
+					Key:  "url",
+					Path: "uri",
+				},
+			},
+			Volume: &servicebindingv1beta1.VolumeOptions{
+				Consolidated: true,
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "cache-secret",
+			},
+		},
+	}
+	workload := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "hello",
+						},
+						{
+							Name: "world",
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scratch",
+						},
+					},
+				},
+			},
+		},
+	}
+	dbSources := []corev1.VolumeProjection{
+		{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "db-secret",
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  "password",
+						Path: "db/password",
+						Mode: pointer.Int32(0400),
+					},
+					{
+						Key:  "username",
+						Path: "db/username",
+						Mode: pointer.Int32(0400),
+					},
+				},
+			},
+		},
+		{
+			DownwardAPI: &corev1.DownwardAPIProjection{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{
+						Path: "db/type",
+						FieldRef: &corev1.ObjectFieldSelector{
+							FieldPath: "metadata.annotations['projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2']",
+						},
+						Mode: pointer.Int32(0400),
+					},
+				},
+			},
+		},
+	}
+	cacheSources := []corev1.VolumeProjection{
+		{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "cache-secret",
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  "url",
+						Path: "cache/uri",
+					},
+				},
+			},
+		},
+	}
+	expected := func(annotations map[string]string, sources []corev1.VolumeProjection) *appsv1.Deployment {
+		mount := []corev1.VolumeMount{
+			{
+				Name:      "servicebinding-consolidated",
+				ReadOnly:  true,
+				MountPath: "/bindings",
+			},
+		}
+		env := []corev1.EnvVar{
+			{
+				Name:  "SERVICE_BINDING_ROOT",
+				Value: "/bindings",
+			},
+		}
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: annotations,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:         "hello",
+								Env:          env,
+								VolumeMounts: mount,
+							},
+							{
+								Name:         "world",
+								Env:          env,
+								VolumeMounts: mount,
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "scratch",
+							},
+							{
+								Name: "servicebinding-consolidated",
+								VolumeSource: corev1.VolumeSource{
+									Projected: &corev1.ProjectedVolumeSource{
+										Sources: sources,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	dbAnnotations := map[string]string{
+		"projector.servicebinding.io/path-26894874-4719-4802-8f43-8ceed127b4c2":   "db",
+		"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "db-secret",
+		"projector.servicebinding.io/type-26894874-4719-4802-8f43-8ceed127b4c2":   "mysql",
+	}
+	cacheAnnotations := map[string]string{
+		"projector.servicebinding.io/path-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d":   "cache",
+		"projector.servicebinding.io/secret-5d1a1d3c-6e5b-4f43-9f4a-0b8c5f4f1e2d": "cache-secret",
+	}
+	bothAnnotations := map[string]string{}
+	for k, v := range dbAnnotations {
+		bothAnnotations[k] = v
+	}
+	for k, v := range cacheAnnotations {
+		bothAnnotations[k] = v
+	}
+	bothSources := append(append([]corev1.VolumeProjection{}, cacheSources...), dbSources...)
+
+	ctx := context.TODO()
+	p := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}))
+
+	project := func(t *testing.T, workload *appsv1.Deployment, bindings ...*servicebindingv1beta1.ServiceBinding) {
+		for _, binding := range bindings {
+			if err := p.Project(ctx, binding, workload); err != nil {
+				t.Fatalf("Project() unexpected err: %v", err)
+			}
+		}
+	}
+	unproject := func(t *testing.T, workload *appsv1.Deployment, bindings ...*servicebindingv1beta1.ServiceBinding) {
+		for _, binding := range bindings {
+			if err := p.Unproject(ctx, binding, workload); err != nil {
+				t.Fatalf("Unproject() unexpected err: %v", err)
+			}
+		}
+	}
+
+	t.Run("project single binding", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1)
+		if diff := cmp.Diff(expected(dbAnnotations, dbSources), actual); diff != "" {
+			t.Errorf("Project() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("project is order independent", func(t *testing.T) {
+		forward := workload.DeepCopy()
+		project(t, forward, binding1, binding2)
+		reverse := workload.DeepCopy()
+		project(t, reverse, binding2, binding1)
+		if diff := cmp.Diff(expected(bothAnnotations, bothSources), forward); diff != "" {
+			t.Errorf("Project() (-expected, +actual): %s", diff)
+		}
+		if diff := cmp.Diff(forward, reverse); diff != "" {
+			t.Errorf("Project() order dependent (-forward, +reverse): %s", diff)
+		}
+	})
+
+	t.Run("project is idempotent", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1, binding2, binding1, binding2)
+		if diff := cmp.Diff(expected(bothAnnotations, bothSources), actual); diff != "" {
+			t.Errorf("Project() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("unproject removes only the binding's sources", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1, binding2)
+		unproject(t, actual, binding1)
+		if diff := cmp.Diff(expected(cacheAnnotations, cacheSources), actual); diff != "" {
+			t.Errorf("Unproject() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("unproject renamed binding", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1, binding2)
+		renamed := binding1.DeepCopy()
+		renamed.Spec.Name = "database"
+		unproject(t, actual, renamed)
+		if diff := cmp.Diff(expected(cacheAnnotations, cacheSources), actual); diff != "" {
+			t.Errorf("Unproject() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("unproject last binding removes the volume", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1, binding2)
+		unproject(t, actual, binding2, binding1)
+		unbound := workload.DeepCopy()
+		unbound.Spec.Template.Annotations = map[string]string{}
+		for i := range unbound.Spec.Template.Spec.Containers {
+			unbound.Spec.Template.Spec.Containers[i].Env = []corev1.EnvVar{
+				{
+					Name:  "SERVICE_BINDING_ROOT",
+					Value: "/bindings",
+				},
+			}
+			unbound.Spec.Template.Spec.Containers[i].VolumeMounts = []corev1.VolumeMount{}
+		}
+		if diff := cmp.Diff(unbound, actual); diff != "" {
+			t.Errorf("Unproject() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("switch from a volume per binding", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		unconsolidated := binding1.DeepCopy()
+		unconsolidated.Spec.Volume = nil
+		project(t, actual, unconsolidated, binding1)
+		if diff := cmp.Diff(expected(dbAnnotations, dbSources), actual); diff != "" {
+			t.Errorf("Project() (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("secret keys not yet known", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		unresolved := binding2.DeepCopy()
+		unresolved.Spec.Files = nil
+		project(t, actual, unresolved)
+		if diff := cmp.Diff(0, len(actual.Spec.Template.Spec.Containers[0].VolumeMounts)); diff != "" {
+			t.Errorf("Project() volume mounts (-expected, +actual): %s", diff)
+		}
+		if diff := cmp.Diff(workload.Spec.Template.Spec.Volumes, actual.Spec.Template.Spec.Volumes); diff != "" {
+			t.Errorf("Project() volumes (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("directory collides with another consolidated binding", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1)
+		duplicate := binding2.DeepCopy()
+		duplicate.Spec.Name = "db"
+		err := p.Project(ctx, duplicate, actual)
+		var collisionErr *CollisionError
+		if !errors.As(err, &collisionErr) {
+			t.Fatalf("Project() expected CollisionError, got: %v", err)
+		}
+		expectedErr := &CollisionError{
+			Kind:       ChangeKindVolumeMount,
+			Container:  "hello",
+			Name:       "/bindings/db",
+			BindingUID: uid1,
+		}
+		if diff := cmp.Diff(expectedErr, collisionErr); diff != "" {
+			t.Errorf("Project() err (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("volume per binding collides with consolidated directory", func(t *testing.T) {
+		actual := workload.DeepCopy()
+		project(t, actual, binding1)
+		unconsolidated := binding2.DeepCopy()
+		unconsolidated.Spec.Name = "db"
+		unconsolidated.Spec.Volume = nil
+		err := p.Project(ctx, unconsolidated, actual)
+		var collisionErr *CollisionError
+		if !errors.As(err, &collisionErr) {
+			t.Fatalf("Project() expected CollisionError, got: %v", err)
+		}
+		expectedErr := &CollisionError{
+			Kind:       ChangeKindVolumeMount,
+			Container:  "hello",
+			Name:       "/bindings/db",
+			BindingUID: uid1,
+		}
+		if diff := cmp.Diff(expectedErr, collisionErr); diff != "" {
+			t.Errorf("Project() err (-expected, +actual): %s", diff)
+		}
+	})
+}
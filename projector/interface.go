@@ -31,6 +31,23 @@ type ServiceBindingProjector interface {
 	Unproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error
 }
 
+// ServiceBindingPlanner is optionally implemented by a ServiceBindingProjector that can preview a projection. The projector
+// returned by New implements it.
+type ServiceBindingPlanner interface {
+	// Plan the changes to the workload from projecting the service as defined by the ServiceBinding, or unprojecting it if
+	// the ServiceBinding is terminating. The workload is not mutated.
+	Plan(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error)
+}
+
+// ServiceBindingApplyProjector is optionally implemented by a ServiceBindingProjector that can unproject a workload as the
+// base of a server-side apply configuration. The projector returned by New implements it.
+type ServiceBindingApplyProjector interface {
+	// UnprojectForApply unprojects the service from the workload, as the base that a server-side apply configuration is
+	// computed against. Unlike Unproject, the SERVICE_BINDING_ROOT env var is also removed from each container the service
+	// is projected into, so that the configuration includes it.
+	UnprojectForApply(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error
+}
+
 type MappingSource interface {
 	// LookupMapping the mapping template for the workload. Typically a ClusterWorkloadResourceMapping is defined for the workload's
 	// fully qualified resource `{resource}.{group}`. The workload's version is either directly matched, or the wildcard version `*`
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -93,23 +94,53 @@ func NewMetaPodTemplate(ctx context.Context, workload runtime.Object, mapping *s
 					return nil, err
 				}
 			}
-			if err := mpt.getAt(mpt.mapping.Containers[i].Env, cv, &mc.Env); err != nil {
+			if err := mpt.getListAt(mpt.mapping.Containers[i].Env, mpt.mapping.Containers[i].EnvShape, cv, &mc.Env); err != nil {
 				return nil, err
 			}
-			if err := mpt.getAt(mpt.mapping.Containers[i].VolumeMounts, cv, &mc.VolumeMounts); err != nil {
+			if err := mpt.getListAt(mpt.mapping.Containers[i].VolumeMounts, mpt.mapping.Containers[i].VolumeMountsShape, cv, &mc.VolumeMounts); err != nil {
 				return nil, err
 			}
 
 			mpt.Containers = append(mpt.Containers, mc)
 		}
 	}
-	if err := mpt.getAt(mpt.mapping.Volumes, uv, &mpt.Volumes); err != nil {
+	if err := mpt.getListAt(mpt.mapping.Volumes, mpt.mapping.VolumesShape, uv, &mpt.Volumes); err != nil {
 		return nil, err
 	}
 
 	return mpt, nil
 }
 
+// NewMetaPodTemplates coerces the workload object into a MetaPodTemplate for each pod template defined by the mapping.
+// A mapping without templates defines a single pod template. Each MetaPodTemplate only writes its own fragments of the
+// workload, so the MetaPodTemplates may be written to the workload in turn.
+func NewMetaPodTemplates(ctx context.Context, workload runtime.Object, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) ([]*metaPodTemplate, error) {
+	if len(mapping.Templates) == 0 {
+		mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+		if err != nil {
+			return nil, err
+		}
+		return []*metaPodTemplate{mpt}, nil
+	}
+
+	mpts := make([]*metaPodTemplate, len(mapping.Templates))
+	for i := range mapping.Templates {
+		t := mapping.Templates[i]
+		mpt, err := NewMetaPodTemplate(ctx, workload, &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+			Version:      mapping.Version,
+			Annotations:  t.Annotations,
+			Containers:   t.Containers,
+			Volumes:      t.Volumes,
+			VolumesShape: t.VolumesShape,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mpts[i] = mpt
+	}
+	return mpts, nil
+}
+
 // WriteToWorkload applies mutation defined on the MetaPodTemplate since it was created to the workload resource the
 // MetaPodTemplate was created from. This method should generally be called once per instance.
 func (mpt *metaPodTemplate) WriteToWorkload(ctx context.Context) error {
@@ -140,17 +171,17 @@ func (mpt *metaPodTemplate) WriteToWorkload(ctx context.Context) error {
 					return err
 				}
 			}
-			if err := mpt.setAt(mpt.mapping.Containers[i].Env, &mpt.Containers[ci].Env, cv); err != nil {
+			if err := mpt.setListAt(mpt.mapping.Containers[i].Env, mpt.mapping.Containers[i].EnvShape, &mpt.Containers[ci].Env, cv); err != nil {
 				return err
 			}
-			if err := mpt.setAt(mpt.mapping.Containers[i].VolumeMounts, &mpt.Containers[ci].VolumeMounts, cv); err != nil {
+			if err := mpt.setListAt(mpt.mapping.Containers[i].VolumeMounts, mpt.mapping.Containers[i].VolumeMountsShape, &mpt.Containers[ci].VolumeMounts, cv); err != nil {
 				return err
 			}
 
 			ci++
 		}
 	}
-	if err := mpt.setAt(mpt.mapping.Volumes, &mpt.Volumes, uv); err != nil {
+	if err := mpt.setListAt(mpt.mapping.Volumes, mpt.mapping.VolumesShape, &mpt.Volumes, uv); err != nil {
 		return err
 	}
 
@@ -159,13 +190,12 @@ func (mpt *metaPodTemplate) WriteToWorkload(ctx context.Context) error {
 }
 
 func (mpt *metaPodTemplate) getAt(ptr string, source reflect.Value, target interface{}) error {
-	parent := reflect.ValueOf(nil)
 	createIfNil := false
 	keys, err := mpt.keys(ptr)
 	if err != nil {
 		return err
 	}
-	v, _, _, err := mpt.find(source, parent, keys, "", createIfNil)
+	v, _, err := mpt.find(source, nil, keys, createIfNil)
 	if err != nil {
 		return err
 	}
@@ -184,9 +214,11 @@ func (mpt *metaPodTemplate) setAt(ptr string, value interface{}, target reflect.
 	if err != nil {
 		return err
 	}
-	parent := reflect.ValueOf(nil)
+	if len(keys) == 0 {
+		return fmt.Errorf("unable to set value at empty path %q", ptr)
+	}
 	createIfNil := true
-	_, vp, lk, err := mpt.find(target, parent, keys, "", createIfNil)
+	_, set, err := mpt.find(target, nil, keys, createIfNil)
 	if err != nil {
 		return err
 	}
@@ -208,11 +240,126 @@ func (mpt *metaPodTemplate) setAt(ptr string, value interface{}, target reflect.
 	if err := json.Unmarshal(b, &out); err != nil {
 		return err
 	}
-	vp.SetMapIndex(reflect.ValueOf(lk), reflect.ValueOf(out))
+	set(reflect.ValueOf(out))
 	return nil
 }
 
-func (mpt *metaPodTemplate) keys(ptr string) ([]string, error) {
+// getListAt reads a collection of named items into target, converting from the shape of the collection within the
+// workload to a list.
+func (mpt *metaPodTemplate) getListAt(ptr string, shape servicebindingv1beta1.ClusterWorkloadResourceMappingShape, source reflect.Value, target interface{}) error {
+	if shape != servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap {
+		return mpt.getAt(ptr, source, target)
+	}
+	m := map[string]interface{}{}
+	if err := mpt.getAt(ptr, source, &m); err != nil {
+		return err
+	}
+	items, err := mapShapeToList(m)
+	if err != nil {
+		return fmt.Errorf("unable to read %q: %w", ptr, err)
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+// setListAt writes a list of named items into the workload, converting to the shape of the collection within the
+// workload.
+func (mpt *metaPodTemplate) setListAt(ptr string, shape servicebindingv1beta1.ClusterWorkloadResourceMappingShape, value interface{}, target reflect.Value) error {
+	if shape != servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap {
+		return mpt.setAt(ptr, value, target)
+	}
+	// the existing entries determine which items are written in shorthand
+	existing := map[string]interface{}{}
+	if err := mpt.getAt(ptr, target, &existing); err != nil {
+		return err
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	items := []map[string]interface{}{}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	m := listToMapShape(items, existing)
+	return mpt.setAt(ptr, &m, target)
+}
+
+// mapShapeToList converts a map of items keyed by name to a list of items with a name field. Items are sorted by name
+// since a map has no intrinsic order.
+func mapShapeToList(m map[string]interface{}) ([]map[string]interface{}, error) {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		item := map[string]interface{}{}
+		switch v := m[name].(type) {
+		case nil:
+		case string:
+			item["value"] = v
+		case map[string]interface{}:
+			for k := range v {
+				item[k] = v[k]
+			}
+		default:
+			return nil, fmt.Errorf("unsupported value of type %T for %q", v, name)
+		}
+		item["name"] = name
+		items[i] = item
+	}
+	return items, nil
+}
+
+// listToMapShape converts a list of items with a name field to a map keyed by name. An item with only a value is
+// written as a string when the existing entry for that name was a string, and an empty item as null when the existing
+// entry was null, so a round trip preserves the original structure.
+func listToMapShape(items []map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		name, _ := item["name"].(string)
+		delete(item, "name")
+		if v, ok := existing[name]; ok && v == nil && len(item) == 0 {
+			m[name] = nil
+			continue
+		}
+		if _, ok := existing[name].(string); ok {
+			if value, ok := item["value"].(string); ok && len(item) == 1 {
+				m[name] = value
+				continue
+			}
+			if len(item) == 0 {
+				// an empty value is omitted when marshaled
+				m[name] = ""
+				continue
+			}
+		}
+		m[name] = item
+	}
+	return m
+}
+
+// pathKey is a single step within a Restricted JSONPath, either a field name or a fixed array index.
+type pathKey struct {
+	Field   string
+	Index   int
+	IsIndex bool
+}
+
+func (k pathKey) String() string {
+	if k.IsIndex {
+		return fmt.Sprintf("[%d]", k.Index)
+	}
+	return fmt.Sprintf("[%q]", k.Field)
+}
+
+func (mpt *metaPodTemplate) keys(ptr string) ([]pathKey, error) {
 	p, err := jsonpath.Parse("", fmt.Sprintf("{%s}", ptr))
 	if err != nil {
 		return nil, err
@@ -220,11 +367,11 @@ func (mpt *metaPodTemplate) keys(ptr string) ([]string, error) {
 	return mpt.fieldKeys(p.Root)
 }
 
-func (mpt *metaPodTemplate) fieldKeys(node jsonpath.Node) ([]string, error) {
+func (mpt *metaPodTemplate) fieldKeys(node jsonpath.Node) ([]pathKey, error) {
 	switch node.Type() {
 	case jsonpath.NodeList:
 		list := node.(*jsonpath.ListNode)
-		paths := []string{}
+		paths := []pathKey{}
 		for i := range list.Nodes {
 			nestedpaths, err := mpt.fieldKeys(list.Nodes[i])
 			if err != nil {
@@ -235,35 +382,72 @@ func (mpt *metaPodTemplate) fieldKeys(node jsonpath.Node) ([]string, error) {
 		return paths, nil
 	case jsonpath.NodeField:
 		field := node.(*jsonpath.FieldNode)
-		return []string{field.Value}, nil
+		return []pathKey{{Field: field.Value}}, nil
+	case jsonpath.NodeArray:
+		array := node.(*jsonpath.ArrayNode)
+		start, end, step := array.Params[0], array.Params[1], array.Params[2]
+		if !start.Known || start.Value < 0 || !end.Derived || step.Known {
+			// only a fixed non-negative index is supported, not slices, wildcards or negative indexes
+			return nil, fmt.Errorf("unsupported array node %s found", array)
+		}
+		return []pathKey{{Index: start.Value, IsIndex: true}}, nil
 	default:
 		return nil, fmt.Errorf("unsupported node type %q found", node.Type())
 	}
 }
 
-func (mpt *metaPodTemplate) find(value, parent reflect.Value, keys []string, lastKey string, createIfNil bool) (reflect.Value, reflect.Value, string, error) {
-	if !value.IsValid() || value.IsNil() {
+// find walks the keys from value returning the referenced value and a func that replaces the referenced value within
+// its parent. When createIfNil is true, missing maps and slices along the path are created. A missing array element is
+// only created when it is the next element in the slice, an index beyond the end of the slice is an error since the
+// elements in between cannot be safely defaulted.
+func (mpt *metaPodTemplate) find(value reflect.Value, set func(reflect.Value), keys []pathKey, createIfNil bool) (reflect.Value, func(reflect.Value), error) {
+	if len(keys) == 0 {
+		return value, set, nil
+	}
+	if value.IsValid() && value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	key := keys[0]
+	if !value.IsValid() || ((value.Kind() == reflect.Map || value.Kind() == reflect.Slice) && value.IsNil()) {
 		if !createIfNil {
-			return reflect.ValueOf(nil), reflect.ValueOf(nil), "", nil
+			return reflect.ValueOf(nil), nil, nil
 		}
-		value = reflect.ValueOf(make(map[string]interface{}))
-		parent.SetMapIndex(reflect.ValueOf(lastKey), value)
-	}
-	if len(keys) == 0 {
-		return value, parent, lastKey, nil
+		if key.IsIndex {
+			value = reflect.ValueOf([]interface{}{})
+		} else {
+			value = reflect.ValueOf(map[string]interface{}{})
+		}
+		set(value)
 	}
 	switch value.Kind() {
 	case reflect.Map:
-		lastKey = keys[0]
-		keys = keys[1:]
-		parent = value
-		value = value.MapIndex(reflect.ValueOf(lastKey))
-		return mpt.find(value, parent, keys, lastKey, createIfNil)
-	case reflect.Interface:
-		parent = value
-		value = value.Elem()
-		return mpt.find(value, parent, keys, lastKey, createIfNil)
+		if key.IsIndex {
+			return reflect.ValueOf(nil), nil, fmt.Errorf("unable to index map with %s", key)
+		}
+		parent := value
+		mk := reflect.ValueOf(key.Field)
+		return mpt.find(parent.MapIndex(mk), func(v reflect.Value) {
+			parent.SetMapIndex(mk, v)
+		}, keys[1:], createIfNil)
+	case reflect.Slice:
+		if !key.IsIndex {
+			return reflect.ValueOf(nil), nil, fmt.Errorf("unable to index slice with %s", key)
+		}
+		if key.Index >= value.Len() {
+			if !createIfNil {
+				return reflect.ValueOf(nil), nil, nil
+			}
+			if key.Index > value.Len() {
+				return reflect.ValueOf(nil), nil, fmt.Errorf("index %s is out of range for slice of length %d", key, value.Len())
+			}
+			value = reflect.Append(value, reflect.Zero(value.Type().Elem()))
+			set(value)
+		}
+		elem := value.Index(key.Index)
+		return mpt.find(elem, func(v reflect.Value) {
+			elem.Set(v)
+		}, keys[1:], createIfNil)
 	default:
-		return reflect.ValueOf(nil), parent, lastKey, fmt.Errorf("unhandled kind %q", value.Kind())
+		return reflect.ValueOf(nil), nil, fmt.Errorf("unhandled kind %q", value.Kind())
 	}
 }
@@ -26,6 +26,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
@@ -359,6 +360,345 @@ func TestNewMetaPodTemplate(t *testing.T) {
 				Volumes: []corev1.Volume{},
 			},
 		},
+		{
+			name: "array index",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[1].template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.podSets[1].template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.podSets[1].template.spec.volumes",
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											"ignored": "value",
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											"key": "value",
+										},
+									},
+									"spec": map[string]interface{}{
+										"containers": []interface{}{
+											map[string]interface{}{
+												"name": "hello",
+												"env": []interface{}{
+													map[string]interface{}{
+														"name":  "NAME",
+														"value": "value",
+													},
+												},
+												"volumeMounts": []interface{}{
+													map[string]interface{}{
+														"name":      "name",
+														"mountPath": "/mount/path",
+													},
+												},
+											},
+										},
+										"volumes": []interface{}{
+											map[string]interface{}{
+												"name": "name",
+												"secret": map[string]interface{}{
+													"secretName": "my-secret",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers: []metaContainer{
+					{
+						Name:         pointer.String("hello"),
+						Env:          []corev1.EnvVar{testEnv},
+						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
+					},
+				},
+				Volumes: []corev1.Volume{testVolume},
+			},
+		},
+		{
+			name: "nested array indexes",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:         ".spec.template.spec.containers[*]",
+						Name:         ".name",
+						Env:          ".envs[0][1]",
+						VolumeMounts: ".mounts[0]",
+					},
+				},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name": "hello",
+										"envs": []interface{}{
+											[]interface{}{
+												[]interface{}{},
+												[]interface{}{
+													map[string]interface{}{
+														"name":  "NAME",
+														"value": "value",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &metaPodTemplate{
+				Annotations: map[string]string{},
+				Containers: []metaContainer{
+					{
+						Name:         pointer.String("hello"),
+						Env:          []corev1.EnvVar{testEnv},
+						VolumeMounts: []corev1.VolumeMount{},
+					},
+				},
+				Volumes: []corev1.Volume{},
+			},
+		},
+		{
+			name: "array index out of range",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[1].template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.podSets[1].template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.podSets[1].template.spec.volumes",
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											"ignored": "value",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &metaPodTemplate{
+				Annotations: map[string]string{},
+				Containers:  []metaContainer{},
+				Volumes:     []corev1.Volume{},
+			},
+		},
+		{
+			name: "array index of map",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.template[0].metadata.annotations",
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: testAnnotations,
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "field of array",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Volumes: ".spec.template.spec.containers.volumes",
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unsupported array slice",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[0:1].template.metadata.annotations",
+			},
+			workload:    &appsv1.Deployment{},
+			expectedErr: true,
+		},
+		{
+			name: "unsupported negative array index",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[-1].template.metadata.annotations",
+			},
+			workload:    &appsv1.Deployment{},
+			expectedErr: true,
+		},
+		{
+			name: "map shapes",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:              ".spec.containers[*]",
+						Name:              ".name",
+						EnvShape:          servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+						VolumeMountsShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+					},
+				},
+				Volumes:      ".spec.volumes",
+				VolumesShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "MapWorkload",
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "hello",
+								"env": map[string]interface{}{
+									"NAME": "value",
+									"SECRET": map[string]interface{}{
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": "my-secret",
+												"key":  "password",
+											},
+										},
+									},
+								},
+								"volumeMounts": map[string]interface{}{
+									"name": map[string]interface{}{
+										"mountPath": "/mount/path",
+									},
+								},
+							},
+						},
+						"volumes": map[string]interface{}{
+							"name": map[string]interface{}{
+								"secret": map[string]interface{}{
+									"secretName": "my-secret",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &metaPodTemplate{
+				Annotations: map[string]string{},
+				Containers: []metaContainer{
+					{
+						Name: pointer.String("hello"),
+						Env: []corev1.EnvVar{
+							testEnv,
+							{
+								Name: "SECRET",
+								ValueFrom: &corev1.EnvVarSource{
+									SecretKeyRef: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "my-secret",
+										},
+										Key: "password",
+									},
+								},
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
+					},
+				},
+				Volumes: []corev1.Volume{testVolume},
+			},
+		},
+		{
+			name: "map shape with unsupported value",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:     ".spec.containers[*]",
+						EnvShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+					},
+				},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "MapWorkload",
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"env": map[string]interface{}{
+									"NAME": int64(42),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "map shape of list",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				VolumesShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{testVolume},
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "invalid container jsonpath",
 			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
@@ -402,6 +742,189 @@ func TestNewMetaPodTemplate(t *testing.T) {
 	}
 }
 
+func TestNewMetaPodTemplates(t *testing.T) {
+	testEnv := corev1.EnvVar{
+		Name:  "NAME",
+		Value: "value",
+	}
+	workload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "DriverExecutorApp",
+			"spec": map[string]interface{}{
+				"driver": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"role": "driver",
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "driver",
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "NAME",
+										"value": "value",
+									},
+								},
+							},
+						},
+					},
+				},
+				"executor": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"role": "executor",
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "executor",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		mapping     *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+		workload    runtime.Object
+		expected    []*metaPodTemplate
+		expectedErr bool
+	}{
+		{
+			name: "single template",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.driver.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.driver.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.driver.spec.volumes",
+			},
+			workload: workload,
+			expected: []*metaPodTemplate{
+				{
+					Annotations: map[string]string{
+						"role": "driver",
+					},
+					Containers: []metaContainer{
+						{
+							Name:         pointer.String("driver"),
+							Env:          []corev1.EnvVar{testEnv},
+							VolumeMounts: []corev1.VolumeMount{},
+						},
+					},
+					Volumes: []corev1.Volume{},
+				},
+			},
+		},
+		{
+			name: "multiple templates",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Templates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Annotations: ".spec.driver.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{
+								Path: ".spec.driver.spec.containers[*]",
+								Name: ".name",
+							},
+						},
+						Volumes: ".spec.driver.spec.volumes",
+					},
+					{
+						Annotations: ".spec.executor.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{
+								Path: ".spec.executor.spec.containers[*]",
+								Name: ".name",
+							},
+						},
+						Volumes: ".spec.executor.spec.volumes",
+					},
+				},
+			},
+			workload: workload,
+			expected: []*metaPodTemplate{
+				{
+					Annotations: map[string]string{
+						"role": "driver",
+					},
+					Containers: []metaContainer{
+						{
+							Name:         pointer.String("driver"),
+							Env:          []corev1.EnvVar{testEnv},
+							VolumeMounts: []corev1.VolumeMount{},
+						},
+					},
+					Volumes: []corev1.Volume{},
+				},
+				{
+					Annotations: map[string]string{
+						"role": "executor",
+					},
+					Containers: []metaContainer{
+						{
+							Name:         pointer.String("executor"),
+							Env:          []corev1.EnvVar{},
+							VolumeMounts: []corev1.VolumeMount{},
+						},
+					},
+					Volumes: []corev1.Volume{},
+				},
+			},
+		},
+		{
+			name: "invalid template",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Templates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Annotations: ".spec.driver.metadata.annotations",
+						Volumes:     ".spec.driver.spec.volumes",
+					},
+					{
+						Annotations: ".spec.executor.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{
+								Path: "[",
+							},
+						},
+						Volumes: ".spec.executor.spec.volumes",
+					},
+				},
+			},
+			workload:    workload,
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			c.mapping.Default()
+			actual, err := NewMetaPodTemplates(ctx, c.workload, c.mapping)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("NewMetaPodTemplates() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual, cmpopts.IgnoreUnexported(metaPodTemplate{})); diff != "" {
+				t.Errorf("NewMetaPodTemplates() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestMetaPodTemplate_WriteToWorkload(t *testing.T) {
 	testAnnotations := map[string]string{
 		"key": "value",
@@ -683,6 +1206,399 @@ func TestMetaPodTemplate_WriteToWorkload(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "array index",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[1].template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.podSets[1].template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.podSets[1].template.spec.volumes",
+			},
+			metadata: metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers: []metaContainer{
+					{
+						Name:         pointer.String("hello"),
+						Env:          []corev1.EnvVar{testEnv},
+						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
+					},
+				},
+				Volumes: []corev1.Volume{testVolume},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{
+							map[string]interface{}{
+								"name": "preserved",
+							},
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"spec": map[string]interface{}{
+										"containers": []interface{}{
+											map[string]interface{}{
+												"name": "hello",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{
+							map[string]interface{}{
+								"name": "preserved",
+							},
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											"key": "value",
+										},
+									},
+									"spec": map[string]interface{}{
+										"containers": []interface{}{
+											map[string]interface{}{
+												"name": "hello",
+												"env": []interface{}{
+													map[string]interface{}{
+														"name":  "NAME",
+														"value": "value",
+													},
+												},
+												"volumeMounts": []interface{}{
+													map[string]interface{}{
+														"name":      "name",
+														"mountPath": "/mount/path",
+													},
+												},
+											},
+										},
+										"volumes": []interface{}{
+											map[string]interface{}{
+												"name": "name",
+												"secret": map[string]interface{}{
+													"secretName": "my-secret",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "array index creates next element",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[0].template.metadata.annotations",
+				Containers:  []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{},
+				Volumes:     ".spec.podSets[0].template.spec.volumes",
+			},
+			metadata: metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers:  []metaContainer{},
+				Volumes:     []corev1.Volume{testVolume},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec":       map[string]interface{}{},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{
+							map[string]interface{}{
+								"template": map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											"key": "value",
+										},
+									},
+									"spec": map[string]interface{}{
+										"volumes": []interface{}{
+											map[string]interface{}{
+												"name": "name",
+												"secret": map[string]interface{}{
+													"secretName": "my-secret",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "nested array indexes",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:         ".spec.template.spec.containers[*]",
+						Env:          ".envs[0][1]",
+						VolumeMounts: ".mounts[0]",
+					},
+				},
+				Volumes: ".spec.template.spec.volumes",
+			},
+			metadata: metaPodTemplate{
+				Annotations: map[string]string{},
+				Containers: []metaContainer{
+					{
+						Env:          []corev1.EnvVar{testEnv},
+						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
+					},
+				},
+				Volumes: []corev1.Volume{},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"envs": []interface{}{
+											[]interface{}{
+												[]interface{}{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"metadata": map[string]interface{}{
+								"annotations": map[string]interface{}{},
+							},
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"envs": []interface{}{
+											[]interface{}{
+												[]interface{}{},
+												[]interface{}{
+													map[string]interface{}{
+														"name":  "NAME",
+														"value": "value",
+													},
+												},
+											},
+										},
+										"mounts": []interface{}{
+											[]interface{}{
+												map[string]interface{}{
+													"name":      "name",
+													"mountPath": "/mount/path",
+												},
+											},
+										},
+									},
+								},
+								"volumes": []interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "array index beyond next element",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets[1].template.metadata.annotations",
+				Containers:  []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{},
+				Volumes:     ".spec.podSets[1].template.spec.volumes",
+			},
+			metadata: metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers:  []metaContainer{},
+				Volumes:     []corev1.Volume{testVolume},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "array index of map",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.template[0].metadata.annotations",
+			},
+			metadata: metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers:  []metaContainer{},
+				Volumes:     []corev1.Volume{},
+			},
+			workload:    &appsv1.Deployment{},
+			expectedErr: true,
+		},
+		{
+			name: "field of array",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.podSets.template.metadata.annotations",
+				Containers:  []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{},
+			},
+			metadata: metaPodTemplate{
+				Annotations: testAnnotations,
+				Containers:  []metaContainer{},
+				Volumes:     []corev1.Volume{},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "PodSetWorkload",
+					"spec": map[string]interface{}{
+						"podSets": []interface{}{},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "map shapes",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:              ".spec.containers[*]",
+						Name:              ".name",
+						EnvShape:          servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+						VolumeMountsShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+					},
+				},
+				Volumes:      ".spec.volumes",
+				VolumesShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+			},
+			metadata: metaPodTemplate{
+				Annotations: map[string]string{},
+				Containers: []metaContainer{
+					{
+						Name: pointer.String("hello"),
+						Env: []corev1.EnvVar{
+							{Name: "EMPTY"},
+							testEnv,
+							{Name: "NULL"},
+							{Name: "OBJECT", Value: "value"},
+							{
+								Name: "SECRET",
+								ValueFrom: &corev1.EnvVarSource{
+									SecretKeyRef: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "my-secret",
+										},
+										Key: "password",
+									},
+								},
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
+					},
+				},
+				Volumes: []corev1.Volume{testVolume},
+			},
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "MapWorkload",
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "hello",
+								"env": map[string]interface{}{
+									"EMPTY": "",
+									"NAME":  "old-value",
+									"NULL":  nil,
+									"OBJECT": map[string]interface{}{
+										"value": "old-value",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "MapWorkload",
+					"spec": map[string]interface{}{
+						"annotations": map[string]interface{}{},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "hello",
+								"env": map[string]interface{}{
+									"EMPTY": "",
+									"NAME":  "value",
+									"NULL":  nil,
+									"OBJECT": map[string]interface{}{
+										"value": "value",
+									},
+									"SECRET": map[string]interface{}{
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": "my-secret",
+												"key":  "password",
+											},
+										},
+									},
+								},
+								"volumeMounts": map[string]interface{}{
+									"name": map[string]interface{}{
+										"mountPath": "/mount/path",
+									},
+								},
+							},
+						},
+						"volumes": map[string]interface{}{
+							"name": map[string]interface{}{
+								"secret": map[string]interface{}{
+									"secretName": "my-secret",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -711,3 +1627,78 @@ func TestMetaPodTemplate_WriteToWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestMetaPodTemplate_MapShapeRoundTrip(t *testing.T) {
+	ctx := context.TODO()
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Annotations: ".spec.annotations",
+		Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+			{
+				Path:              ".spec.containers[*]",
+				Name:              ".name",
+				EnvShape:          servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+				VolumeMountsShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+			},
+		},
+		Volumes:      ".spec.volumes",
+		VolumesShape: servicebindingv1beta1.ClusterWorkloadResourceMappingShapeMap,
+	}
+	mapping.Default()
+	workload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "MapWorkload",
+			"spec": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"key": "value",
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "hello",
+						"env": map[string]interface{}{
+							"EMPTY": "",
+							"NAME":  "value",
+							"NULL":  nil,
+							"OBJECT": map[string]interface{}{
+								"value": "value",
+							},
+							"SECRET": map[string]interface{}{
+								"valueFrom": map[string]interface{}{
+									"secretKeyRef": map[string]interface{}{
+										"name": "my-secret",
+										"key":  "password",
+									},
+								},
+							},
+						},
+						"volumeMounts": map[string]interface{}{
+							"name": map[string]interface{}{
+								"mountPath": "/mount/path",
+								"readOnly":  true,
+							},
+						},
+					},
+				},
+				"volumes": map[string]interface{}{
+					"name": map[string]interface{}{
+						"secret": map[string]interface{}{
+							"secretName": "my-secret",
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := workload.DeepCopy()
+
+	mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+	if err != nil {
+		t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+	}
+	if err := mpt.WriteToWorkload(ctx); err != nil {
+		t.Fatalf("WriteToWorkload() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(expected, workload); diff != "" {
+		t.Errorf("round trip (-expected, +actual): %s", diff)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+type ChangeAction string
+
+const (
+	ChangeActionAdd    ChangeAction = "add"
+	ChangeActionUpdate ChangeAction = "update"
+	ChangeActionRemove ChangeAction = "remove"
+)
+
+type ChangeKind string
+
+const (
+	ChangeKindAnnotation  ChangeKind = "annotation"
+	ChangeKindVolume      ChangeKind = "volume"
+	ChangeKindVolumeMount ChangeKind = "volume mount"
+	ChangeKindEnv         ChangeKind = "env var"
+)
+
+// Change describes a single annotation, volume, volume mount or environment variable that is added to, updated within
+// or removed from a workload.
+type Change struct {
+	Action ChangeAction
+	Kind   ChangeKind
+	// Template is the index of the pod template, like `[1]`, when the workload resource mapping defines more than one
+	// template.
+	Template string
+	// Container is the name of the container for volume mount and env var changes. Containers without a name are
+	// identified by their index, like `[0]`.
+	Container string
+	// Name is the name of the item that changed
+	Name string
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %q", c.Action, c.Kind, c.Name)
+	if c.Container != "" {
+		s = fmt.Sprintf("%s in container %q", s, c.Container)
+	}
+	if c.Template != "" {
+		s = fmt.Sprintf("%s in template %q", s, c.Template)
+	}
+	return s
+}
+
+// Plan describes the changes to a workload from projecting, or unprojecting, a service binding.
+type Plan struct {
+	// Patch is the JSON Patch that transforms the workload into the projected workload. Operations must be applied in
+	// order.
+	Patch []jsonpatch.Operation
+	// Changes are the individual items in the workload's pod template that change
+	Changes []Change
+}
+
+// Summary returns a human readable description of the changes, one per line.
+func (p *Plan) Summary() string {
+	if len(p.Changes) == 0 {
+		return "no changes"
+	}
+	lines := make([]string, len(p.Changes))
+	for i := range p.Changes {
+		lines[i] = p.Changes[i].String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *serviceBindingProjector) Plan(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error) {
+	mapping, err := p.mappingSource.LookupMapping(ctx, workload)
+	if err != nil {
+		return nil, err
+	}
+	current, err := NewMetaPodTemplates(ctx, workload, mapping)
+	if err != nil {
+		return nil, err
+	}
+	projectedWorkload := workload.DeepCopyObject()
+	projected, err := NewMetaPodTemplates(ctx, projectedWorkload, mapping)
+	if err != nil {
+		return nil, err
+	}
+	changes := []Change{}
+	for i := range projected {
+		if !binding.DeletionTimestamp.IsZero() {
+			p.unprojectUnits(binding, projected[i])
+		} else if err := p.projectUnits(binding, projected[i]); err != nil {
+			return nil, err
+		}
+		if err := projected[i].WriteToWorkload(ctx); err != nil {
+			return nil, err
+		}
+		template := ""
+		if len(projected) > 1 {
+			template = fmt.Sprintf("[%d]", i)
+		}
+		changes = append(changes, p.changes(template, current[i], projected[i])...)
+	}
+
+	currentBytes, err := json.Marshal(workload)
+	if err != nil {
+		return nil, err
+	}
+	projectedBytes, err := json.Marshal(projectedWorkload)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreatePatch(currentBytes, projectedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Plan{
+		Patch:   patch,
+		Changes: changes,
+	}, nil
+}
+
+func (p *serviceBindingProjector) changes(template string, current, projected *metaPodTemplate) []Change {
+	changes := []Change{}
+
+	currentAnnotations, projectedAnnotations := map[string]interface{}{}, map[string]interface{}{}
+	for k, v := range current.Annotations {
+		currentAnnotations[k] = v
+	}
+	for k, v := range projected.Annotations {
+		projectedAnnotations[k] = v
+	}
+	changes = append(changes, p.namedChanges(ChangeKindAnnotation, template, "", currentAnnotations, projectedAnnotations)...)
+
+	currentVolumes, projectedVolumes := map[string]interface{}{}, map[string]interface{}{}
+	for _, v := range current.Volumes {
+		currentVolumes[v.Name] = v
+	}
+	for _, v := range projected.Volumes {
+		projectedVolumes[v.Name] = v
+	}
+	changes = append(changes, p.namedChanges(ChangeKindVolume, template, "", currentVolumes, projectedVolumes)...)
+
+	// projection never adds or removes containers, they are matched by index
+	for i := range projected.Containers {
+		cc, pc := current.Containers[i], projected.Containers[i]
+		container := p.containerName(&pc, i)
+
+		currentMounts, projectedMounts := map[string]interface{}{}, map[string]interface{}{}
+		for _, m := range cc.VolumeMounts {
+			currentMounts[m.Name] = m
+		}
+		for _, m := range pc.VolumeMounts {
+			projectedMounts[m.Name] = m
+		}
+		changes = append(changes, p.namedChanges(ChangeKindVolumeMount, template, container, currentMounts, projectedMounts)...)
+
+		currentEnv, projectedEnv := map[string]interface{}{}, map[string]interface{}{}
+		for _, e := range cc.Env {
+			currentEnv[e.Name] = e
+		}
+		for _, e := range pc.Env {
+			projectedEnv[e.Name] = e
+		}
+		changes = append(changes, p.namedChanges(ChangeKindEnv, template, container, currentEnv, projectedEnv)...)
+	}
+
+	return changes
+}
+
+func (p *serviceBindingProjector) namedChanges(kind ChangeKind, template, container string, current, projected map[string]interface{}) []Change {
+	changes := []Change{}
+	names := sets.StringKeySet(current).Union(sets.StringKeySet(projected))
+	for _, name := range names.List() {
+		cv, inCurrent := current[name]
+		pv, inProjected := projected[name]
+		change := Change{
+			Kind:      kind,
+			Template:  template,
+			Container: container,
+			Name:      name,
+		}
+		switch {
+		case !inCurrent:
+			change.Action = ChangeActionAdd
+		case !inProjected:
+			change.Action = ChangeActionRemove
+		case !equality.Semantic.DeepEqual(cv, pv):
+			change.Action = ChangeActionUpdate
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gomodules.xyz/jsonpatch/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+func TestPlan(t *testing.T) {
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	bindingName := "my-binding"
+	secretName := "my-secret"
+	now := metav1.Now()
+
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: bindingName,
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "USERNAME",
+					Key:  "username",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: secretName,
+			},
+		},
+	}
+	workload := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"preserve": "me",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "hello",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "SERVICE_BINDING_ROOT",
+									Value: "/bindings",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	projectedWorkload := workload.DeepCopy()
+	if err := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})).Project(context.TODO(), binding, projectedWorkload); err != nil {
+		t.Fatalf("Project() unexpected err: %v", err)
+	}
+	multiServiceBinding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Services: []servicebindingv1beta1.ServiceBindingServiceEntry{
+				{
+					Name: "db",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Services: []servicebindingv1beta1.ServiceBindingServiceStatus{
+				{
+					Name: "db",
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+		},
+	}
+	terminatingBinding := binding.DeepCopy()
+	terminatingBinding.DeletionTimestamp = &now
+
+	tests := []struct {
+		name            string
+		mapping         MappingSource
+		binding         *servicebindingv1beta1.ServiceBinding
+		workload        runtime.Object
+		expectedPatch   []jsonpatch.Operation
+		expectedSummary string
+		expectedErr     bool
+	}{
+		{
+			name:     "project",
+			mapping:  NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding:  binding,
+			workload: workload,
+			expectedPatch: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/spec/template/metadata/annotations/projector.servicebinding.io~1secret-26894874-4719-4802-8f43-8ceed127b4c2",
+					Value:     "my-secret",
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/containers/0/env/1",
+					Value: map[string]interface{}{
+						"name": "USERNAME",
+						"valueFrom": map[string]interface{}{
+							"secretKeyRef": map[string]interface{}{
+								"name": "my-secret",
+								"key":  "username",
+							},
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/containers/0/volumeMounts",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name":      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+							"readOnly":  true,
+							"mountPath": "/bindings/my-binding",
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/volumes",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+							"projected": map[string]interface{}{
+								"sources": []interface{}{
+									map[string]interface{}{
+										"secret": map[string]interface{}{
+											"name": "my-secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSummary: `add annotation "projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2"
+add volume "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2"
+add volume mount "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2" in container "hello"
+add env var "USERNAME" in container "hello"`,
+		},
+		{
+			name:     "project each service",
+			mapping:  NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding:  multiServiceBinding,
+			workload: workload,
+			expectedPatch: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/spec/template/metadata/annotations/projector.servicebinding.io~1secret-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
+					Value:     "my-secret",
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/containers/0/volumeMounts",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name":      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
+							"readOnly":  true,
+							"mountPath": "/bindings/db",
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/volumes",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1",
+							"projected": map[string]interface{}{
+								"sources": []interface{}{
+									map[string]interface{}{
+										"secret": map[string]interface{}{
+											"name": "my-secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSummary: `add annotation "projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1"
+add volume "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1"
+add volume mount "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2-7bdc25d1" in container "hello"`,
+		},
+		{
+			name:            "already projected",
+			mapping:         NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding:         binding,
+			workload:        projectedWorkload,
+			expectedPatch:   []jsonpatch.Operation{},
+			expectedSummary: "no changes",
+		},
+		{
+			name:     "unproject terminating binding",
+			mapping:  NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding:  terminatingBinding,
+			workload: projectedWorkload,
+			expectedPatch: []jsonpatch.Operation{
+				{
+					Operation: "remove",
+					Path:      "/spec/template/metadata/annotations/projector.servicebinding.io~1secret-26894874-4719-4802-8f43-8ceed127b4c2",
+				},
+				{
+					Operation: "remove",
+					Path:      "/spec/template/spec/containers/0/env/1",
+				},
+				{
+					Operation: "remove",
+					Path:      "/spec/template/spec/containers/0/volumeMounts",
+				},
+				{
+					Operation: "remove",
+					Path:      "/spec/template/spec/volumes",
+				},
+			},
+			expectedSummary: `remove annotation "projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2"
+remove volume "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2"
+remove volume mount "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2" in container "hello"
+remove env var "USERNAME" in container "hello"`,
+		},
+		{
+			name: "multiple templates",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Templates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Annotations: ".spec.driver.metadata.annotations",
+						Volumes:     ".spec.driver.spec.volumes",
+					},
+					{
+						Annotations: ".spec.executor.metadata.annotations",
+						Volumes:     ".spec.executor.spec.volumes",
+					},
+				},
+			}),
+			binding: binding,
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "DriverExecutorApp",
+					"spec": map[string]interface{}{
+						"driver":   map[string]interface{}{},
+						"executor": map[string]interface{}{},
+					},
+				},
+			},
+			expectedPatch: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/spec/driver/metadata",
+					Value: map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/driver/spec",
+					Value: map[string]interface{}{
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": "my-secret",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/executor/metadata",
+					Value: map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": "my-secret",
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/executor/spec",
+					Value: map[string]interface{}{
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": "my-secret",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSummary: `add annotation "projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2" in template "[0]"
+add volume "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2" in template "[0]"
+add annotation "projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2" in template "[1]"
+add volume "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2" in template "[1]"`,
+		},
+		{
+			name: "invalid container jsonpath",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: "[",
+					},
+				},
+			}),
+			binding:     binding,
+			workload:    workload,
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			original := c.workload.DeepCopyObject()
+			plan, err := New(c.mapping).(ServiceBindingPlanner).Plan(ctx, c.binding, c.workload)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("Plan() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			// the order of operations on distinct fields is not stable
+			sort.SliceStable(plan.Patch, func(i, j int) bool {
+				return plan.Patch[i].Path < plan.Patch[j].Path
+			})
+			if diff := cmp.Diff(c.expectedPatch, plan.Patch); diff != "" {
+				t.Errorf("Plan() patch (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.expectedSummary, plan.Summary()); diff != "" {
+				t.Errorf("Plan() summary (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(original, c.workload); diff != "" {
+				t.Errorf("Plan() mutated workload (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	servicebindingv1beta1 "github.com/servicebinding/runtime/apis/v1beta1"
+)
+
+// A binding that defines services is projected as a unit for each service. A unit is a copy of the binding for a single
+// service, it is identified by the binding's uid and a digest of the service's name so the volume, annotations and env
+// vars projected for each service are independent of the other services. The digest keeps the names of projected
+// items within the length limits of a volume name and an annotation key.
+
+// ServiceUnits returns the binding for each service bound by the binding, with the spec and status of the service. A
+// binding without services is its own unit.
+func ServiceUnits(binding *servicebindingv1beta1.ServiceBinding) []*servicebindingv1beta1.ServiceBinding {
+	if len(binding.Spec.Services) == 0 {
+		return []*servicebindingv1beta1.ServiceBinding{binding}
+	}
+	units := make([]*servicebindingv1beta1.ServiceBinding, len(binding.Spec.Services))
+	for i := range binding.Spec.Services {
+		unit := binding.DeepCopy()
+		service := unit.Spec.Services[i]
+		unit.Spec.Name = service.Name
+		unit.Spec.Type = service.Type
+		unit.Spec.Provider = service.Provider
+		unit.Spec.Service = service.Service
+		unit.Spec.Env = service.Env
+		unit.Spec.Services = []servicebindingv1beta1.ServiceBindingServiceEntry{service}
+		unit.Status.Conditions = nil
+		unit.Status.Binding = nil
+		for _, status := range unit.Status.Services {
+			if status.Name == service.Name {
+				unit.Status.Conditions = status.Conditions
+				unit.Status.Binding = status.Binding
+			}
+		}
+		unit.Status.Services = nil
+		units[i] = unit
+	}
+	return units
+}
+
+// UnitID returns the identity of the binding as projected into a workload. The binding's uid, suffixed by a digest of
+// the service's name for a unit of a binding with services.
+func UnitID(binding *servicebindingv1beta1.ServiceBinding) types.UID {
+	if len(binding.Spec.Services) == 0 {
+		return binding.UID
+	}
+	h := sha256.Sum256([]byte(binding.Spec.Services[0].Name))
+	return types.UID(fmt.Sprintf("%s-%s", binding.UID, hex.EncodeToString(h[:])[:8]))
+}
+
+// staleUnits returns the units projected into the pod template for the binding that are not one of the units, like the
+// unit of a service that was removed from the binding. Only the identity of a stale unit is known, which is enough to
+// unproject it.
+func (p *serviceBindingProjector) staleUnits(binding *servicebindingv1beta1.ServiceBinding, units []*servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) []*servicebindingv1beta1.ServiceBinding {
+	current := sets.NewString()
+	for _, unit := range units {
+		current.Insert(string(UnitID(unit)))
+	}
+	stale := sets.NewString()
+	for k := range mpt.Annotations {
+		for _, prefix := range []string{SecretAnnotationPrefix, PathAnnotationPrefix} {
+			if !strings.HasPrefix(k, prefix) {
+				continue
+			}
+			id := strings.TrimPrefix(k, prefix)
+			if id != string(binding.UID) && !strings.HasPrefix(id, fmt.Sprintf("%s-", binding.UID)) {
+				// projected by another binding
+				continue
+			}
+			if !current.Has(id) {
+				stale.Insert(id)
+			}
+		}
+	}
+	ids := stale.List()
+	staleUnits := make([]*servicebindingv1beta1.ServiceBinding, len(ids))
+	for i, id := range ids {
+		staleUnits[i] = &servicebindingv1beta1.ServiceBinding{}
+		staleUnits[i].UID = types.UID(id)
+	}
+	return staleUnits
+}
@@ -18,7 +18,10 @@ package resolver
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	corev1 "k8s.io/api/core/v1"
@@ -26,7 +29,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
@@ -83,21 +88,270 @@ func (m *clusterResolver) LookupMapping(ctx context.Context, workload runtime.Ob
 	return mapping, nil
 }
 
+func (r *clusterResolver) LookupServiceMapping(ctx context.Context, serviceRef corev1.ObjectReference) (*servicebindingv1beta1.ClusterServiceResourceMappingTemplate, error) {
+	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "Secret" {
+		// direct secret reference
+		return nil, nil
+	}
+	gvk := schema.FromAPIVersionAndKind(serviceRef.APIVersion, serviceRef.Kind)
+	rm, err := r.config.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	srm := &servicebindingv1beta1.ClusterServiceResourceMapping{}
+	err = r.config.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s.%s", rm.Resource.Resource, rm.Resource.Group)}, srm)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			// the service follows the provisioned service duck-type
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// find version mapping
+	var mapping, wildcardMapping *servicebindingv1beta1.ClusterServiceResourceMappingTemplate
+	for i := range srm.Spec.Versions {
+		switch srm.Spec.Versions[i].Version {
+		case gvk.Version:
+			mapping = &srm.Spec.Versions[i]
+		case "*":
+			wildcardMapping = &srm.Spec.Versions[i]
+		}
+	}
+	if mapping == nil {
+		mapping = wildcardMapping
+	}
+	if mapping == nil {
+		return nil, nil
+	}
+
+	mapping = mapping.DeepCopy()
+	mapping.Default()
+
+	return mapping, nil
+}
+
+func (r *clusterResolver) LookupServiceName(ctx context.Context, serviceRef corev1.ObjectReference, selector *metav1.LabelSelector) (string, error) {
+	services := &unstructured.UnstructuredList{}
+	services.SetAPIVersion(serviceRef.APIVersion)
+	services.SetKind(fmt.Sprintf("%sList", serviceRef.Kind))
+	ls, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return "", err
+	}
+	if err := r.config.List(ctx, services, client.InNamespace(serviceRef.Namespace), client.MatchingLabelsSelector{Selector: ls}); err != nil {
+		return "", err
+	}
+
+	var names []string
+	var highest int64
+	for i := range services.Items {
+		// a missing or malformed priority is the default priority
+		priority, _ := strconv.ParseInt(services.Items[i].GetAnnotations()[servicebindingv1beta1.ServicePriorityAnnotation], 10, 64)
+		if len(names) == 0 || priority > highest {
+			names = []string{services.Items[i].GetName()}
+			highest = priority
+		} else if priority == highest {
+			names = append(names, services.Items[i].GetName())
+		}
+	}
+	switch len(names) {
+	case 0:
+		return "", nil
+	case 1:
+		return names[0], nil
+	default:
+		sort.Strings(names)
+		return "", &AmbiguousServiceError{Names: names}
+	}
+}
+
 func (r *clusterResolver) LookupBindingSecret(ctx context.Context, serviceRef corev1.ObjectReference) (string, error) {
 	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "Secret" {
 		// direct secret reference
 		return serviceRef.Name, nil
 	}
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return "", err
+	}
+	mapping, err := r.LookupServiceMapping(ctx, serviceRef)
+	if err != nil {
+		return "", err
+	}
+	if mapping == nil {
+		secretName, exists, err := unstructured.NestedString(service.UnstructuredContent(), "status", "binding", "name")
+		// treat missing values as empty
+		_ = exists
+		return secretName, err
+	}
+	if mapping.Secret != nil {
+		// the binding secret is synthesized from the service
+		return "", nil
+	}
+	secretName, _, err := readJsonPath(service, mapping.SecretName)
+	return secretName, err
+}
+
+func (r *clusterResolver) LookupServiceReadyCondition(ctx context.Context, serviceRef corev1.ObjectReference) (*metav1.Condition, error) {
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return nil, err
+	}
+	conditions, _, err := unstructured.NestedSlice(service.UnstructuredContent(), "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(condition, "type"); t != "Ready" {
+			continue
+		}
+		// read fields individually, conditions of arbitrary services do not always conform to metav1.Condition
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		return &metav1.Condition{
+			Type:    "Ready",
+			Status:  metav1.ConditionStatus(status),
+			Reason:  reason,
+			Message: message,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (r *clusterResolver) SynthesizeBindingSecret(ctx context.Context, serviceRef corev1.ObjectReference, mapping *servicebindingv1beta1.ClusterServiceResourceMappingSecret) (map[string][]byte, error) {
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{}
+	secrets := map[string]*corev1.Secret{}
+	for _, entry := range mapping.Entries {
+		if entry.SecretKeyRef == nil {
+			value, exists, err := readJsonPath(service, entry.Path)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				data[entry.Key] = []byte(value)
+			}
+			continue
+		}
+		secretName, exists, err := readJsonPath(service, entry.SecretKeyRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || secretName == "" {
+			// the service does not reference a secret, yet
+			continue
+		}
+		secret, ok := secrets[secretName]
+		if !ok {
+			secretRef := corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  serviceRef.Namespace,
+				Name:       secretName,
+			}
+			secret, err = r.LookupSecret(ctx, secretRef)
+			if err != nil {
+				if !apierrs.IsNotFound(err) {
+					return nil, err
+				}
+				// the referenced secret is tracked, its entries are added once it is created
+				secret = &corev1.Secret{}
+			}
+			secrets[secretName] = secret
+		}
+		if value, ok := secret.Data[entry.SecretKeyRef.Key]; ok {
+			data[entry.Key] = value
+		}
+	}
+	return data, nil
+}
+
+func (r *clusterResolver) lookupService(ctx context.Context, serviceRef corev1.ObjectReference) (*unstructured.Unstructured, error) {
 	service := &unstructured.Unstructured{}
 	service.SetAPIVersion(serviceRef.APIVersion)
 	service.SetKind(serviceRef.Kind)
 	if err := r.config.TrackAndGet(ctx, client.ObjectKey{Namespace: serviceRef.Namespace, Name: serviceRef.Name}, service); err != nil {
-		return "", err
+		return nil, err
 	}
-	secretName, exists, err := unstructured.NestedString(service.UnstructuredContent(), "status", "binding", "name")
-	// treat missing values as empty
-	_ = exists
-	return secretName, err
+	return service, nil
+}
+
+// readJsonPath returns the value within the object at the JSONPath expression. Values that are not a string are encoded
+// as JSON. A missing value is reported as not existing, rather than as an error.
+func readJsonPath(obj *unstructured.Unstructured, expression string) (string, bool, error) {
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", expression)); err != nil {
+		return "", false, err
+	}
+	results, err := jp.FindResults(obj.UnstructuredContent())
+	if err != nil {
+		return "", false, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return "", false, nil
+	}
+	switch value := results[0][0].Interface().(type) {
+	case string:
+		return value, true, nil
+	case nil:
+		return "", false, nil
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", false, err
+		}
+		return string(b), true, nil
+	}
+}
+
+func (r *clusterResolver) LookupSecret(ctx context.Context, secretRef corev1.ObjectReference) (*corev1.Secret, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Secret")
+	if err := r.config.TrackAndGet(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, obj); err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (r *clusterResolver) LookupBindingTypeProfile(ctx context.Context, bindingType string) (*servicebindingv1beta1.ClusterBindingTypeProfile, error) {
+	profile := &servicebindingv1beta1.ClusterBindingTypeProfile{}
+	if err := r.config.TrackAndGet(ctx, client.ObjectKey{Name: bindingType}, profile); err != nil {
+		if apierrs.IsNotFound(err) {
+			// the binding type does not have a profile
+			return nil, nil
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (r *clusterResolver) LookupServiceGrant(ctx context.Context, bindingRef corev1.ObjectReference, serviceRef corev1.ObjectReference) (*servicebindingv1beta1.ServiceBindingGrant, error) {
+	grants := &servicebindingv1beta1.ServiceBindingGrantList{}
+	if err := r.config.List(ctx, grants, client.InNamespace(serviceRef.Namespace)); err != nil {
+		return nil, err
+	}
+	bindingGVK := schema.FromAPIVersionAndKind(bindingRef.APIVersion, bindingRef.Kind)
+	serviceGVK := schema.FromAPIVersionAndKind(serviceRef.APIVersion, serviceRef.Kind)
+	for i := range grants.Items {
+		if grants.Items[i].Permits(bindingGVK.Group, bindingGVK.Kind, bindingRef.Namespace, serviceGVK.Group, serviceGVK.Kind, serviceRef.Name) {
+			return &grants.Items[i], nil
+		}
+	}
+	return nil, nil
 }
 
 func (r *clusterResolver) LookupWorkloads(ctx context.Context, workloadRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]runtime.Object, error) {
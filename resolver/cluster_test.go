@@ -18,6 +18,7 @@ package resolver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -301,9 +302,125 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 	}
 }
 
+func TestClusterResolver_LookupServiceName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	service := func(name string, labels map[string]interface{}, annotations map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "service.local/v1",
+				"kind":       "ProvisionedService",
+				"metadata": map[string]interface{}{
+					"namespace":   "my-namespace",
+					"name":        name,
+					"labels":      labels,
+					"annotations": annotations,
+				},
+			},
+		}
+	}
+	serviceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "ProvisionedService",
+		Namespace:  "my-namespace",
+	}
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "my-database"},
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		serviceRef   corev1.ObjectReference
+		selector     *metav1.LabelSelector
+		expected     string
+		expectedErr  error
+	}{
+		{
+			name:         "no services",
+			givenObjects: []client.Object{},
+			serviceRef:   serviceRef,
+			selector:     selector,
+			expected:     "",
+		},
+		{
+			name: "single match",
+			givenObjects: []client.Object{
+				service("blue", map[string]interface{}{"app": "my-database"}, nil),
+				service("other", map[string]interface{}{"app": "other"}, nil),
+			},
+			serviceRef: serviceRef,
+			selector:   selector,
+			expected:   "blue",
+		},
+		{
+			name: "highest priority",
+			givenObjects: []client.Object{
+				service("blue", map[string]interface{}{"app": "my-database"}, map[string]interface{}{"servicebinding.io/service-priority": "1"}),
+				service("green", map[string]interface{}{"app": "my-database"}, map[string]interface{}{"servicebinding.io/service-priority": "2"}),
+				service("legacy", map[string]interface{}{"app": "my-database"}, nil),
+			},
+			serviceRef: serviceRef,
+			selector:   selector,
+			expected:   "green",
+		},
+		{
+			name: "tie for the highest priority",
+			givenObjects: []client.Object{
+				service("blue", map[string]interface{}{"app": "my-database"}, nil),
+				service("green", map[string]interface{}{"app": "my-database"}, map[string]interface{}{"servicebinding.io/service-priority": "not-a-number"}),
+			},
+			serviceRef:  serviceRef,
+			selector:    selector,
+			expectedErr: &resolver.AmbiguousServiceError{Names: []string{"blue", "green"}},
+		},
+		{
+			name:         "invalid selector",
+			givenObjects: []client.Object{},
+			serviceRef:   serviceRef,
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "foo",
+					Operator: "NotAnOperator",
+				}},
+			},
+			expectedErr: fmt.Errorf(`"NotAnOperator" is not a valid pod selector operator`),
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "ProvisionedService"}, meta.RESTScopeNamespace)
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupServiceName(ctx, c.serviceRef, c.selector)
+
+			if (err != nil) != (c.expectedErr != nil) || (err != nil && err.Error() != c.expectedErr.Error()) {
+				t.Errorf("LookupServiceName() expected err: %v, actual err: %v", c.expectedErr, err)
+			}
+			if c.expectedErr != nil {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupServiceName() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
 
 	tests := []struct {
 		name         string
@@ -373,6 +490,129 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 			},
 			expected: "",
 		},
+		{
+			name: "found mapped service",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version:    "*",
+								SecretName: ".spec.connection.secretName",
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "MappedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"spec": map[string]interface{}{
+							"connection": map[string]interface{}{
+								"secretName": "my-secret",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "MappedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: "my-secret",
+		},
+		{
+			name: "found mapped service, secret name missing",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version:    "v1",
+								SecretName: ".spec.connection.secretName",
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "MappedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"spec": map[string]interface{}{},
+					},
+				},
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "MappedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: "",
+		},
+		{
+			name: "found mapped service with synthesized secret",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version: "*",
+								Secret: &servicebindingv1beta1.ClusterServiceResourceMappingSecret{
+									Entries: []servicebindingv1beta1.ClusterServiceResourceMappingEntry{
+										{
+											Key:  "host",
+											Path: ".status.host",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "MappedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "my-secret",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "MappedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: "",
+		},
 		{
 			name:         "not found",
 			givenObjects: []client.Object{},
@@ -394,6 +634,10 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
 				Tracker: tracker.New(0),
 			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "ProvisionedService"}, meta.RESTScopeNamespace)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "NotAProvisionedService"}, meta.RESTScopeNamespace)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "MappedService"}, meta.RESTScopeNamespace)
 			resolver := resolver.New(config)
 
 			actual, err := resolver.LookupBindingSecret(ctx, c.serviceRef)
@@ -411,6 +655,751 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 	}
 }
 
+func TestClusterResolver_LookupServiceReadyCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	service := func(conditions ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "service.local/v1",
+				"kind":       "ProvisionedService",
+				"metadata": map[string]interface{}{
+					"namespace": "my-namespace",
+					"name":      "my-service",
+				},
+				"status": map[string]interface{}{
+					"conditions": conditions,
+				},
+			},
+		}
+	}
+	serviceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "ProvisionedService",
+		Namespace:  "my-namespace",
+		Name:       "my-service",
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		serviceRef   corev1.ObjectReference
+		expected     *metav1.Condition
+		expectedErr  bool
+	}{
+		{
+			name: "ready",
+			givenObjects: []client.Object{
+				service(
+					map[string]interface{}{
+						"type":   "Synced",
+						"status": "False",
+					},
+					map[string]interface{}{
+						"type":   "Ready",
+						"status": "True",
+						"reason": "Available",
+					},
+				),
+			},
+			serviceRef: serviceRef,
+			expected: &metav1.Condition{
+				Type:   "Ready",
+				Status: metav1.ConditionTrue,
+				Reason: "Available",
+			},
+		},
+		{
+			name: "not ready",
+			givenObjects: []client.Object{
+				service(
+					map[string]interface{}{
+						"type":    "Ready",
+						"status":  "False",
+						"reason":  "Creating",
+						"message": "the database is being created",
+					},
+				),
+			},
+			serviceRef: serviceRef,
+			expected: &metav1.Condition{
+				Type:    "Ready",
+				Status:  metav1.ConditionFalse,
+				Reason:  "Creating",
+				Message: "the database is being created",
+			},
+		},
+		{
+			name: "no ready condition",
+			givenObjects: []client.Object{
+				service(
+					map[string]interface{}{
+						"type":   "Synced",
+						"status": "True",
+					},
+				),
+			},
+			serviceRef: serviceRef,
+			expected:   nil,
+		},
+		{
+			name: "no conditions",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "ProvisionedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+					},
+				},
+			},
+			serviceRef: serviceRef,
+			expected:   nil,
+		},
+		{
+			name:         "not found",
+			givenObjects: []client.Object{},
+			serviceRef:   serviceRef,
+			expectedErr:  true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "ProvisionedService"}, meta.RESTScopeNamespace)
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupServiceReadyCondition(ctx, c.serviceRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupServiceReadyCondition() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupServiceReadyCondition() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupServiceMapping(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "MappedService",
+		Namespace:  "my-namespace",
+		Name:       "my-service",
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		serviceRef   corev1.ObjectReference
+		expected     *servicebindingv1beta1.ClusterServiceResourceMappingTemplate
+		expectedErr  bool
+	}{
+		{
+			name:         "no mapping",
+			givenObjects: []client.Object{},
+			serviceRef:   serviceRef,
+			expected:     nil,
+		},
+		{
+			name: "direct binding",
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-secret",
+			},
+			expected: nil,
+		},
+		{
+			name: "version mapping",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version:    "*",
+								SecretName: ".spec.wildcard",
+							},
+							{
+								Version:    "v1",
+								SecretName: ".spec.v1",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: serviceRef,
+			expected: &servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+				Version:    "v1",
+				SecretName: ".spec.v1",
+			},
+		},
+		{
+			name: "wildcard mapping, defaulted",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version: "v2",
+								Secret:  &servicebindingv1beta1.ClusterServiceResourceMappingSecret{},
+							},
+							{
+								Version: "*",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: serviceRef,
+			expected: &servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+				Version:    "*",
+				SecretName: ".status.binding.name",
+			},
+		},
+		{
+			name: "no mapping for version",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{
+								Version:    "v2",
+								SecretName: ".spec.v2",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: serviceRef,
+			expected:   nil,
+		},
+		{
+			name: "error if service type not found in restmapper",
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "UnknownService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "MappedService"}, meta.RESTScopeNamespace)
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupServiceMapping(ctx, c.serviceRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupServiceMapping() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupServiceMapping() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_SynthesizeBindingSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	serviceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "Database",
+		Namespace:  "my-namespace",
+		Name:       "my-database",
+	}
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "service.local/v1",
+			"kind":       "Database",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-database",
+			},
+			"spec": map[string]interface{}{
+				"engine":         "postgresql",
+				"masterUsername": "admin",
+				"masterPasswordSecretRef": map[string]interface{}{
+					"name": "my-database-password",
+				},
+			},
+			"status": map[string]interface{}{
+				"endpoint": map[string]interface{}{
+					"address": "db.local",
+					"port":    int64(5432),
+				},
+			},
+		},
+	}
+	passwordSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-database-password",
+		},
+		Data: map[string][]byte{
+			"password": []byte("secret"),
+		},
+	}
+	mapping := &servicebindingv1beta1.ClusterServiceResourceMappingSecret{
+		Entries: []servicebindingv1beta1.ClusterServiceResourceMappingEntry{
+			{
+				Key:  "type",
+				Path: ".spec.engine",
+			},
+			{
+				Key:  "host",
+				Path: ".status.endpoint.address",
+			},
+			{
+				Key:  "port",
+				Path: ".status.endpoint.port",
+			},
+			{
+				Key:  "username",
+				Path: ".spec.masterUsername",
+			},
+			{
+				Key: "password",
+				SecretKeyRef: &servicebindingv1beta1.ClusterServiceResourceMappingSecretKeyRef{
+					Name: ".spec.masterPasswordSecretRef.name",
+					Key:  "password",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		mapping      *servicebindingv1beta1.ClusterServiceResourceMappingSecret
+		expected     map[string][]byte
+		expectedErr  bool
+	}{
+		{
+			name: "synthesize from fields and referenced secret",
+			givenObjects: []client.Object{
+				service,
+				passwordSecret,
+			},
+			mapping: mapping,
+			expected: map[string][]byte{
+				"type":     []byte("postgresql"),
+				"host":     []byte("db.local"),
+				"port":     []byte("5432"),
+				"username": []byte("admin"),
+				"password": []byte("secret"),
+			},
+		},
+		{
+			name: "omit missing values",
+			givenObjects: []client.Object{
+				func() client.Object {
+					s := service.DeepCopy()
+					unstructured.RemoveNestedField(s.Object, "status")
+					unstructured.RemoveNestedField(s.Object, "spec", "masterPasswordSecretRef")
+					return s
+				}(),
+			},
+			mapping: mapping,
+			expected: map[string][]byte{
+				"type":     []byte("postgresql"),
+				"username": []byte("admin"),
+			},
+		},
+		{
+			name: "omit missing keys in referenced secret",
+			givenObjects: []client.Object{
+				service,
+				&corev1.Secret{
+					ObjectMeta: passwordSecret.ObjectMeta,
+				},
+			},
+			mapping: mapping,
+			expected: map[string][]byte{
+				"type":     []byte("postgresql"),
+				"host":     []byte("db.local"),
+				"port":     []byte("5432"),
+				"username": []byte("admin"),
+			},
+		},
+		{
+			name: "omit values from a referenced secret that is not found",
+			givenObjects: []client.Object{
+				service,
+			},
+			mapping: mapping,
+			expected: map[string][]byte{
+				"type":     []byte("postgresql"),
+				"host":     []byte("db.local"),
+				"port":     []byte("5432"),
+				"username": []byte("admin"),
+			},
+		},
+		{
+			name:         "service not found",
+			givenObjects: []client.Object{},
+			mapping:      mapping,
+			expectedErr:  true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.SynthesizeBindingSecret(ctx, serviceRef, c.mapping)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("SynthesizeBindingSecret() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("SynthesizeBindingSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		secretRef    corev1.ObjectReference
+		expected     *corev1.Secret
+		expectedErr  bool
+	}{
+		{
+			name: "found secret",
+			givenObjects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "my-secret",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+					},
+				},
+			},
+			secretRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-secret",
+			},
+			expected: &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Secret",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-secret",
+				},
+				Data: map[string][]byte{
+					"username": []byte("user"),
+				},
+			},
+		},
+		{
+			name:         "not found",
+			givenObjects: []client.Object{},
+			secretRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-secret",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupSecret(ctx, c.secretRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupSecret() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual, rtesting.IgnoreResourceVersion, rtesting.IgnoreCreationTimestamp); diff != "" {
+				t.Errorf("LookupSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupBindingTypeProfile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	profile := &servicebindingv1beta1.ClusterBindingTypeProfile{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servicebindingv1beta1.GroupVersion.String(),
+			Kind:       "ClusterBindingTypeProfile",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "postgresql",
+		},
+		Spec: servicebindingv1beta1.ClusterBindingTypeProfileSpec{
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "PGHOST",
+					Key:  "host",
+				},
+			},
+			RequiredKeys: []string{"host"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		bindingType  string
+		expected     *servicebindingv1beta1.ClusterBindingTypeProfile
+		expectedErr  bool
+	}{
+		{
+			name: "found profile",
+			givenObjects: []client.Object{
+				profile,
+			},
+			bindingType: "postgresql",
+			expected:    profile,
+		},
+		{
+			name: "no profile for type",
+			givenObjects: []client.Object{
+				profile,
+			},
+			bindingType: "redis",
+			expected:    nil,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupBindingTypeProfile(ctx, c.bindingType)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupBindingTypeProfile() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual, rtesting.IgnoreResourceVersion, rtesting.IgnoreCreationTimestamp); diff != "" {
+				t.Errorf("LookupBindingTypeProfile() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupServiceGrant(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	bindingRef := corev1.ObjectReference{
+		APIVersion: "servicebinding.io/v1beta1",
+		Kind:       "ServiceBinding",
+		Namespace:  "my-app",
+		Name:       "my-binding",
+	}
+	serviceRef := corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  "data",
+		Name:       "my-database",
+	}
+	grant := &servicebindingv1beta1.ServiceBindingGrant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servicebindingv1beta1.GroupVersion.String(),
+			Kind:       "ServiceBindingGrant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "data",
+			Name:      "my-grant",
+		},
+		Spec: servicebindingv1beta1.ServiceBindingGrantSpec{
+			From: []servicebindingv1beta1.ServiceBindingGrantFrom{
+				{
+					Group:     "servicebinding.io",
+					Kind:      "ServiceBinding",
+					Namespace: "my-app",
+				},
+			},
+			To: []servicebindingv1beta1.ServiceBindingGrantTo{
+				{
+					Group: "",
+					Kind:  "Secret",
+					Name:  "my-database",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		bindingRef   corev1.ObjectReference
+		serviceRef   corev1.ObjectReference
+		expected     *servicebindingv1beta1.ServiceBindingGrant
+		expectedErr  bool
+	}{
+		{
+			name: "granted",
+			givenObjects: []client.Object{
+				grant,
+			},
+			bindingRef: bindingRef,
+			serviceRef: serviceRef,
+			expected:   grant,
+		},
+		{
+			name:         "no grant",
+			givenObjects: []client.Object{},
+			bindingRef:   bindingRef,
+			serviceRef:   serviceRef,
+			expected:     nil,
+		},
+		{
+			name: "grant for another namespace",
+			givenObjects: []client.Object{
+				grant,
+			},
+			bindingRef: func() corev1.ObjectReference {
+				ref := bindingRef
+				ref.Namespace = "other-app"
+				return ref
+			}(),
+			serviceRef: serviceRef,
+			expected:   nil,
+		},
+		{
+			name: "grant for another service",
+			givenObjects: []client.Object{
+				grant,
+			},
+			bindingRef: bindingRef,
+			serviceRef: func() corev1.ObjectReference {
+				ref := serviceRef
+				ref.Name = "my-cache"
+				return ref
+			}(),
+			expected: nil,
+		},
+		{
+			name: "grant in another namespace",
+			givenObjects: []client.Object{
+				func() client.Object {
+					g := grant.DeepCopy()
+					g.Namespace = "other-data"
+					return g
+				}(),
+			},
+			bindingRef: bindingRef,
+			serviceRef: serviceRef,
+			expected:   nil,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupServiceGrant(ctx, c.bindingRef, c.serviceRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupServiceGrant() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual, rtesting.IgnoreResourceVersion, rtesting.IgnoreCreationTimestamp); diff != "" {
+				t.Errorf("LookupServiceGrant() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestClusterResolver_LookupWorkloads(t *testing.T) {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
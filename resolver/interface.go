@@ -18,6 +18,8 @@ package resolver
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,12 +34,57 @@ type Resolver interface {
 	// mapping template is returned. If no explicit mapping is found, a mapping appropriate for a PodSpecable resource may be used.
 	LookupMapping(ctx context.Context, workload runtime.Object) (*servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, error)
 
+	// LookupServiceMapping returns the mapping template for the service. A ClusterServiceResourceMapping may be defined for the service's
+	// fully qualified resource `{resource}.{group}`. The service's version is either directly matched, or the wildcard version `*` mapping
+	// template is returned. If no mapping is found, nil is returned and the service is expected to follow the Provisioned Service
+	// duck-type.
+	LookupServiceMapping(ctx context.Context, serviceRef corev1.ObjectReference) (*servicebindingv1beta1.ClusterServiceResourceMappingTemplate, error)
+
+	// LookupServiceName returns the name of the service selected by the label selector within the service's namespace. When several
+	// services are selected, the service with the highest integer `servicebinding.io/service-priority` annotation is returned. An
+	// AmbiguousServiceError is returned when several services tie for the highest priority, and an empty name when no service is
+	// selected.
+	LookupServiceName(ctx context.Context, serviceRef corev1.ObjectReference, selector *metav1.LabelSelector) (string, error)
+
 	// LookupBindingSecret returns the binding secret name exposed by the service following the Provisioned Service duck-type
-	// (`.status.binding.name`). If a direction binding is used (where the referenced service is itself a Secret) the referenced Secret is
-	// returned without a lookup.
+	// (`.status.binding.name`), or at the location defined by the service's mapping. If a direction binding is used (where the referenced
+	// service is itself a Secret) the referenced Secret is returned without a lookup. An empty name is returned when the mapping
+	// synthesises the binding secret.
 	LookupBindingSecret(ctx context.Context, serviceRef corev1.ObjectReference) (string, error)
 
+	// LookupServiceReadyCondition returns the Ready condition from the service's `.status.conditions`, or nil if the service does not
+	// report a Ready condition. The service is tracked so that changes to its conditions trigger a reconcile.
+	LookupServiceReadyCondition(ctx context.Context, serviceRef corev1.ObjectReference) (*metav1.Condition, error)
+
+	// SynthesizeBindingSecret returns the entries of a binding secret for the service as defined by the mapping. Values are read from the
+	// service, or from the Secrets the service references. The service and referenced Secrets are tracked so that changes trigger a
+	// reconcile. Entries whose value is missing, including values from a referenced Secret that is not found, are omitted.
+	SynthesizeBindingSecret(ctx context.Context, serviceRef corev1.ObjectReference, mapping *servicebindingv1beta1.ClusterServiceResourceMappingSecret) (map[string][]byte, error)
+
+	// LookupSecret returns the referenced Secret. The Secret is read as an unstructured object to avoid holding every Secret in the
+	// cluster within an informer cache, and is tracked so that changes to the Secret trigger a reconcile.
+	LookupSecret(ctx context.Context, secretRef corev1.ObjectReference) (*corev1.Secret, error)
+
+	// LookupBindingTypeProfile returns the ClusterBindingTypeProfile named for the binding type, or nil if the type does not
+	// have a profile. The profile is tracked so that changes to the profile trigger a reconcile.
+	LookupBindingTypeProfile(ctx context.Context, bindingType string) (*servicebindingv1beta1.ClusterBindingTypeProfile, error)
+
+	// LookupServiceGrant returns a ServiceBindingGrant in the service's namespace that permits the binding to reference the service, or
+	// nil if the reference is not granted. Only a service in a namespace other than the binding's namespace requires a grant. A
+	// reference without a name is granted when any service of the kind may be referenced.
+	LookupServiceGrant(ctx context.Context, bindingRef corev1.ObjectReference, serviceRef corev1.ObjectReference) (*servicebindingv1beta1.ServiceBindingGrant, error)
+
 	// LookupWorkloads returns the referenced objects. Often a unstructured Object is used to sidestep issues with schemes and registered
 	// types. The selector is mutually exclusive with the reference name.
 	LookupWorkloads(ctx context.Context, workloadRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]runtime.Object, error)
 }
+
+// AmbiguousServiceError is returned when several services selected by a service reference tie for the highest priority
+type AmbiguousServiceError struct {
+	// Names of the services that tie for the highest priority
+	Names []string
+}
+
+func (e *AmbiguousServiceError) Error() string {
+	return fmt.Sprintf("services %s are selected with the same priority", strings.Join(e.Names, ", "))
+}